@@ -26,7 +26,7 @@ func main() {
 			},
 		},
 		SwaggerUI: gofiberswagger.SwaggerUIConfig{
-			URL:    "/swagger/swagger.yaml",
+			// URL gets derived from BasePath and YAMLFileName, unless you set it explicitly
 			Title:  "Swagger UI - title of the swagger UI page",
 			Layout: "StandaloneLayout",
 			Plugins: []template.JS{
@@ -55,12 +55,16 @@ func main() {
 		FilterOutAppUse:          true,
 		RequiredAuth:             nil,
 		AutomaticallyRequireAuth: false,
+		BasePath:                 "/internal/docs",
+		IndexFileName:            "index.html",
+		JSONFileName:             "openapi.json",
+		YAMLFileName:             "openapi.yaml",
 	})
 
 	// You can now see your:
-	// - UI at /swagger/
-	// - json at /swagger/swagger.json
-	// - yaml at /swagger/swagger.yaml
+	// - UI at /internal/docs/
+	// - json at /internal/docs/openapi.json
+	// - yaml at /internal/docs/openapi.yaml
 
 	log.Fatal(app.Listen(":3000"))
 }
@@ -23,7 +23,7 @@ func main() {
 		),
 	}, HelloHandler)
 
-	gofiberswagger.Register(app, gofiberswagger.DefaultConfig)
+	gofiberswagger.Register(app, &gofiberswagger.DefaultConfig)
 
 	log.Fatal(app.Listen(":3000"))
 }
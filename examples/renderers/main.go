@@ -0,0 +1,45 @@
+package main
+
+import (
+	"log"
+
+	"github.com/TDiblik/gofiber-swagger/gofiberswagger"
+	"github.com/gofiber/fiber/v3"
+)
+
+func main() {
+	app := fiber.New()
+
+	router := gofiberswagger.NewRouter(app)
+	router.Get("/", nil, HelloHandler)
+
+	// Use ReDoc as the main docs page and serve other renderers side by side
+	gofiberswagger.Register(app, &gofiberswagger.Config{
+		Renderer: gofiberswagger.ReDocConfig{
+			Title:              "My API",
+			HideDownloadButton: true,
+		},
+		AdditionalRenderers: map[string]gofiberswagger.Renderer{
+			"swagger-ui": gofiberswagger.SwaggerUIConfig{},
+			"scalar":     gofiberswagger.ScalarConfig{Theme: "moon"},
+			"rapidoc":    gofiberswagger.RapiDocConfig{Theme: "dark"},
+			"elements":   gofiberswagger.StoplightElementsConfig{},
+		},
+	})
+
+	// You can now see your:
+	// - ReDoc at /swagger/
+	// - Swagger UI at /swagger/swagger-ui
+	// - Scalar at /swagger/scalar
+	// - RapiDoc at /swagger/rapidoc
+	// - Stoplight Elements at /swagger/elements
+	// - json at /swagger/swagger.json
+	// - yaml at /swagger/swagger.yaml
+
+	log.Fatal(app.Listen(":3000"))
+}
+
+// ----- Hello Handler and it's types ----- //
+func HelloHandler(c fiber.Ctx) error {
+	return c.SendStatus(200)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"log"
+
+	"github.com/TDiblik/gofiber-swagger/gofiberswagger"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/basicauth"
+)
+
+func main() {
+	// public api, which we want to document
+	app := fiber.New()
+	router := gofiberswagger.NewRouter(app)
+	router.Get("/", nil, HelloHandler)
+
+	// internal admin app, which serves the docs (protected by basic auth)
+	admin := fiber.New()
+	gofiberswagger.Register(app, &gofiberswagger.Config{
+		DocsRouter: admin,
+		DocsMiddleware: []any{basicauth.New(basicauth.Config{
+			Users: map[string]string{
+				// password: "admin"
+				"admin": "{SHA256}jGl25bVBBBW96Qi9Te4V37Fnqchz/Eu4qB9vKrRIqRg=",
+			},
+		})},
+	})
+
+	// You can now see your (on the admin app):
+	// - UI at http://localhost:3001/swagger/
+	// - json at http://localhost:3001/swagger/swagger.json
+	// - yaml at http://localhost:3001/swagger/swagger.yaml
+	go func() {
+		log.Fatal(admin.Listen(":3001"))
+	}()
+	log.Fatal(app.Listen(":3000"))
+}
+
+// ----- Hello Handler and it's types ----- //
+func HelloHandler(c fiber.Ctx) error {
+	return c.SendStatus(200)
+}
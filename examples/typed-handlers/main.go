@@ -0,0 +1,56 @@
+package main
+
+import (
+	"log"
+
+	"github.com/TDiblik/gofiber-swagger/gofiberswagger"
+	"github.com/gofiber/fiber/v3"
+)
+
+func main() {
+	app := fiber.New()
+
+	router := gofiberswagger.NewRouter(app)
+
+	// The request body, parameters and the 200 response get derived from the handler's types
+	router.Put("/users/:id", nil, gofiberswagger.Handle(UpdateUserHandler))
+
+	// You can still provide additional docs, declared values take precedence
+	router.Get("/users", &gofiberswagger.RouteInfo{
+		Summary: "List users",
+	}, gofiberswagger.Handle(ListUsersHandler))
+
+	// When not using the SwaggerRouter, unwrap the handler and the docs manually
+	listUsers := gofiberswagger.Handle(ListUsersHandler)
+	app.Get("/v2/users", listUsers.Handler())
+	gofiberswagger.RegisterRoute("GET", "/v2/users", listUsers.RouteInfo(nil))
+
+	gofiberswagger.Register(app, &gofiberswagger.DefaultConfig)
+
+	log.Fatal(app.Listen(":3000"))
+}
+
+// ----- Update User Handler and it's types ----- //
+type UpdateUserRequest struct {
+	ID     int    `uri:"id"`
+	DryRun bool   `query:"dry_run"`
+	Name   string `json:"name" validate:"required"`
+}
+type User struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func UpdateUserHandler(c fiber.Ctx, req UpdateUserRequest) (User, error) {
+	return User{ID: req.ID, Name: req.Name}, nil
+}
+
+// ----- List Users Handler and it's types ----- //
+type ListUsersRequest struct {
+	Page  int `query:"page" default:"1"`
+	Limit int `query:"limit" default:"20"`
+}
+
+func ListUsersHandler(c fiber.Ctx, req ListUsersRequest) ([]User, error) {
+	return []User{{ID: 1, Name: "john"}}, nil
+}
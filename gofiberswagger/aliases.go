@@ -103,7 +103,7 @@ func NewRequestBodyFullyCustom[T any](description string, required bool, consume
 	request_body := openapi3.NewRequestBody()
 	request_body.WithDescription(description)
 	request_body.WithRequired(required)
-	schema := CreateSchema[T]()
+	schema := createHelperSchema[T]()
 	request_body.WithSchemaRef(schema, consumes)
 	return &RequestBodyRef{Value: request_body}
 }
@@ -140,6 +140,14 @@ func NewResponseInfoRaw[T any](code string, description string, mediatype string
 	}
 }
 
+func NewResponseInfoNoContent(code string, description string) ResponseInfo {
+	return ResponseInfo{
+		Code:        code,
+		Description: description,
+		Response:    &ResponseRef{Value: openapi3.NewResponse().WithDescription(description)},
+	}
+}
+
 func NewResponsesRaw(responses map[string]*ResponseRef) *Responses {
 	output := &Responses{}
 	for k, v := range responses {
@@ -149,7 +157,7 @@ func NewResponsesRaw(responses map[string]*ResponseRef) *Responses {
 }
 func NewResponseRawJSON[T any](description string) *ResponseRef {
 	response := openapi3.NewResponse()
-	schema := CreateSchema[T]()
+	schema := createHelperSchema[T]()
 	response.WithJSONSchemaRef(schema)
 	response.WithDescription(description)
 	return &ResponseRef{Value: response}
@@ -162,7 +170,7 @@ func NewResponseRaw[T any](mediaType string, description string, additonalMediaT
 		additonalMediaTypeInfo = &MediaType{}
 	}
 	if additonalMediaTypeInfo.Schema == nil {
-		schema := CreateSchema[T]()
+		schema := createHelperSchema[T]()
 		additonalMediaTypeInfo.Schema = schema
 	}
 
@@ -186,7 +194,7 @@ func NewParameters(parameters ...*ParameterRef) Parameters {
 
 func INewPathParameter[T any](name string) *ParameterRef {
 	param_raw := openapi3.NewPathParameter(name)
-	param_raw.Schema = CreateSchema[T]()
+	param_raw.Schema = createHelperSchema[T]()
 	return &ParameterRef{Value: param_raw}
 }
 
@@ -208,7 +216,7 @@ func NewPathParameterExtended(name string, schema *Schema) *ParameterRef {
 
 func INewQueryParameter[T any](name string) *ParameterRef {
 	param_raw := openapi3.NewQueryParameter(name)
-	param_raw.Schema = CreateSchema[T]()
+	param_raw.Schema = createHelperSchema[T]()
 	return &ParameterRef{Value: param_raw}
 }
 
@@ -240,7 +248,7 @@ func NewQueryParameterExtended(name string, schema *Schema) *ParameterRef {
 
 func INewHeaderParameter[T any](name string) *ParameterRef {
 	param_raw := openapi3.NewHeaderParameter(name)
-	param_raw.Schema = CreateSchema[T]()
+	param_raw.Schema = createHelperSchema[T]()
 	return &ParameterRef{Value: param_raw}
 }
 
@@ -272,7 +280,7 @@ func NewHeaderParameterExtended(name string, schema *Schema) *ParameterRef {
 
 func INewCookieParameter[T any](name string) *ParameterRef {
 	param_raw := openapi3.NewCookieParameter(name)
-	param_raw.Schema = CreateSchema[T]()
+	param_raw.Schema = createHelperSchema[T]()
 	return &ParameterRef{Value: param_raw}
 }
 
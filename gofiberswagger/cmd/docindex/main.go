@@ -0,0 +1,119 @@
+// Command docindex precomputes the doc comments of a package for gofiberswagger's Config.UseDocComments,
+// so that binaries shipped without their sources still have the summaries and descriptions filled.
+//
+// Add the following line to a file of the package (e.g. main.go) and run `go generate`:
+//
+//	//go:generate go run github.com/TDiblik/gofiber-swagger/gofiberswagger/cmd/docindex
+//
+// Flags:
+//
+//	-dir  directory of the package (default: ".")
+//	-pkg  import path of the package (default: "main" for main packages, otherwise resolved using `go list`)
+//	-o    output file (default: "gofiberswagger_docs.go")
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/TDiblik/gofiber-swagger/gofiberswagger"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package")
+	pkg_path := flag.String("pkg", "", "import path of the package")
+	output := flag.String("o", "gofiberswagger_docs.go", "output file")
+	flag.Parse()
+
+	pkg_name, err := packageName(*dir)
+	if err != nil {
+		log.Fatalln("docindex:", err)
+	}
+	if *pkg_path == "" {
+		*pkg_path, err = importPath(*dir, pkg_name)
+		if err != nil {
+			log.Fatalln("docindex:", err)
+		}
+	}
+
+	comments, err := gofiberswagger.CollectDocComments(*dir, *pkg_path)
+	if err != nil {
+		log.Fatalln("docindex:", err)
+	}
+
+	source, err := generate(pkg_name, comments)
+	if err != nil {
+		log.Fatalln("docindex:", err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), source, 0o644); err != nil {
+		log.Fatalln("docindex:", err)
+	}
+}
+
+// name of the package inside dir, `go generate` provides it using $GOPACKAGE
+func packageName(dir string) (string, error) {
+	if name := os.Getenv("GOPACKAGE"); name != "" {
+		return name, nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return "", err
+		}
+		return parsed.Name.Name, nil
+	}
+	return "", fmt.Errorf("no go files found inside %q", dir)
+}
+
+// import path as seen by reflect / runtime ("main" for main packages)
+func importPath(dir string, pkg_name string) (string, error) {
+	if pkg_name == "main" {
+		return "main", nil
+	}
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}", ".")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve the import path, use the -pkg flag: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func generate(pkg_name string, comments map[string]string) ([]byte, error) {
+	keys := make([]string, 0, len(comments))
+	for key := range comments {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	buffer := bytes.Buffer{}
+	buffer.WriteString("// Code generated by gofiberswagger/cmd/docindex. DO NOT EDIT.\n\n")
+	buffer.WriteString("package " + pkg_name + "\n\n")
+	buffer.WriteString("import \"github.com/TDiblik/gofiber-swagger/gofiberswagger\"\n\n")
+	buffer.WriteString("func init() {\n")
+	buffer.WriteString("gofiberswagger.RegisterDocComments(map[string]string{\n")
+	for _, key := range keys {
+		buffer.WriteString(strconv.Quote(key) + ": " + strconv.Quote(comments[key]) + ",\n")
+	}
+	buffer.WriteString("})\n}\n")
+
+	return format.Source(buffer.Bytes())
+}
@@ -0,0 +1,515 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	swaggerPkgPath = "github.com/TDiblik/gofiber-swagger/gofiberswagger"
+	fiberPkgPath   = "github.com/gofiber/fiber/v3"
+)
+
+// SwaggerRouter method -> http method
+var routeMethods = map[string]string{
+	"Get":     "GET",
+	"Head":    "HEAD",
+	"Post":    "POST",
+	"Put":     "PUT",
+	"Delete":  "DELETE",
+	"Connect": "CONNECT",
+	"Options": "OPTIONS",
+	"Trace":   "TRACE",
+	"Patch":   "PATCH",
+}
+
+// fiber.Bind method -> request body helper, the other Bind methods declare parameters
+var bodyBinders = map[string]string{
+	"Body":    "NewRequestBody",
+	"JSON":    "NewRequestBodyJSON",
+	"XML":     "NewRequestBodyXML",
+	"Form":    "NewRequestBodyFormData",
+	"CBOR":    "NewRequestBody",
+	"MsgPack": "NewRequestBody",
+}
+var parameterBinders = map[string]bool{"Query": true, "Header": true, "Cookie": true, "URI": true, "All": true}
+
+type analyzedParameter struct {
+	In   string
+	Name string
+	Type types.Type // nil for plain strings
+}
+
+type analyzedResponse struct {
+	Status int
+	Type   types.Type // nil when the content is unknown
+}
+
+type analyzedRoute struct {
+	Method   string
+	Path     string
+	Position token.Position
+	Handler  string
+
+	Parameters      []analyzedParameter
+	ParametersFrom  []types.Type
+	RequestBody     types.Type
+	RequestBodyFunc string
+	Responses       []analyzedResponse
+}
+
+func (route *analyzedRoute) isEmpty() bool {
+	return len(route.Parameters) == 0 && len(route.ParametersFrom) == 0 && route.RequestBody == nil && len(route.Responses) == 0
+}
+
+func (route *analyzedRoute) addParameter(parameter analyzedParameter) {
+	for i, existing := range route.Parameters {
+		if existing.In == parameter.In && existing.Name == parameter.Name {
+			if existing.Type == nil {
+				route.Parameters[i].Type = parameter.Type
+			}
+			return
+		}
+	}
+	route.Parameters = append(route.Parameters, parameter)
+}
+
+func (route *analyzedRoute) addParametersFrom(t types.Type) {
+	for _, existing := range route.ParametersFrom {
+		if types.Identical(existing, t) {
+			return
+		}
+	}
+	route.ParametersFrom = append(route.ParametersFrom, t)
+}
+
+func (route *analyzedRoute) addResponse(response analyzedResponse) {
+	for i, existing := range route.Responses {
+		if existing.Status == response.Status {
+			if existing.Type == nil {
+				route.Responses[i].Type = response.Type
+			}
+			return
+		}
+	}
+	route.Responses = append(route.Responses, response)
+}
+
+type funcSource struct {
+	body *ast.BlockStmt
+	info *types.Info
+}
+
+type analyzer struct {
+	packages []*packages.Package
+	funcs    map[*types.Func]funcSource
+	// variables / parameters holding a SwaggerRouter -> it's group prefix
+	prefixes    map[types.Object]string
+	conflicting map[types.Object]bool
+
+	routes   []*analyzedRoute
+	warnings []string
+}
+
+func newAnalyzer(pkgs []*packages.Package) *analyzer {
+	a := &analyzer{
+		packages:    pkgs,
+		funcs:       map[*types.Func]funcSource{},
+		prefixes:    map[types.Object]string{},
+		conflicting: map[types.Object]bool{},
+	}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+					if obj, ok := pkg.TypesInfo.Defs[fn.Name].(*types.Func); ok {
+						a.funcs[obj] = funcSource{body: fn.Body, info: pkg.TypesInfo}
+					}
+				}
+			}
+		}
+	}
+	return a
+}
+
+// finds the routes registered through SwaggerRouter without docs and infers their docs from the handlers
+func (a *analyzer) analyze() []*analyzedRoute {
+	// routers get passed around (variables, function parameters), resolve their prefixes until nothing changes
+	for range 10 {
+		changed := false
+		for _, pkg := range a.packages {
+			for _, file := range pkg.Syntax {
+				ast.Inspect(file, func(node ast.Node) bool {
+					changed = a.collectPrefixes(pkg.TypesInfo, node) || changed
+					return true
+				})
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	for _, pkg := range a.packages {
+		for _, file := range pkg.Syntax {
+			ast.Inspect(file, func(node ast.Node) bool {
+				if call, ok := node.(*ast.CallExpr); ok {
+					a.analyzeRouteCall(pkg, call)
+				}
+				return true
+			})
+		}
+	}
+	return a.routes
+}
+
+func (a *analyzer) setPrefix(obj types.Object, prefix string) bool {
+	if obj == nil || a.conflicting[obj] {
+		return false
+	}
+	if existing, ok := a.prefixes[obj]; ok {
+		if existing == prefix {
+			return false
+		}
+		delete(a.prefixes, obj)
+		a.conflicting[obj] = true
+		return true
+	}
+	a.prefixes[obj] = prefix
+	return true
+}
+
+func (a *analyzer) collectPrefixes(info *types.Info, node ast.Node) bool {
+	changed := false
+	switch node := node.(type) {
+	case *ast.AssignStmt:
+		if len(node.Lhs) != len(node.Rhs) {
+			return false
+		}
+		for i, rhs := range node.Rhs {
+			ident, ok := node.Lhs[i].(*ast.Ident)
+			if !ok {
+				continue
+			}
+			if prefix, ok := a.routerPrefix(info, rhs); ok {
+				changed = a.setPrefix(info.ObjectOf(ident), prefix) || changed
+			}
+		}
+	case *ast.ValueSpec:
+		if len(node.Names) != len(node.Values) {
+			return false
+		}
+		for i, value := range node.Values {
+			if prefix, ok := a.routerPrefix(info, value); ok {
+				changed = a.setPrefix(info.ObjectOf(node.Names[i]), prefix) || changed
+			}
+		}
+	case *ast.CallExpr:
+		// routers passed as arguments of functions declared inside the analyzed packages
+		callee := typeutil.StaticCallee(info, node)
+		if callee == nil {
+			return false
+		}
+		if _, ok := a.funcs[callee]; !ok {
+			return false
+		}
+		params := callee.Type().(*types.Signature).Params()
+		for i, arg := range node.Args {
+			if i >= params.Len() {
+				break
+			}
+			if prefix, ok := a.routerPrefix(info, arg); ok {
+				changed = a.setPrefix(params.At(i), prefix) || changed
+			}
+		}
+	}
+	return changed
+}
+
+// returns the group prefix of the expression evaluating to a SwaggerRouter
+func (a *analyzer) routerPrefix(info *types.Info, expr ast.Expr) (string, bool) {
+	if !isNamed(info.TypeOf(expr), swaggerPkgPath, "SwaggerRouter") {
+		return "", false
+	}
+	switch expr := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		prefix, ok := a.prefixes[info.ObjectOf(expr)]
+		return prefix, ok
+	case *ast.UnaryExpr:
+		return a.routerPrefix(info, expr.X)
+	case *ast.StarExpr:
+		return a.routerPrefix(info, expr.X)
+	case *ast.CallExpr:
+		callee, ok := typeutil.Callee(info, expr).(*types.Func)
+		if !ok || callee.Pkg() == nil || callee.Pkg().Path() != swaggerPkgPath {
+			return "", false
+		}
+		switch callee.Name() {
+		case "NewRouter", "NewRouterFromRouter":
+			return "", true
+		case "Group":
+			selector, ok := ast.Unparen(expr.Fun).(*ast.SelectorExpr)
+			if !ok || len(expr.Args) == 0 {
+				return "", false
+			}
+			parent, ok := a.routerPrefix(info, selector.X)
+			if !ok {
+				return "", false
+			}
+			group, ok := constantString(info, expr.Args[0])
+			return parent + group, ok
+		}
+	}
+	return "", false
+}
+
+func (a *analyzer) warn(position token.Position, format string, args ...any) {
+	a.warnings = append(a.warnings, fmt.Sprintf("%s: %s", position, fmt.Sprintf(format, args...)))
+}
+
+func (a *analyzer) analyzeRouteCall(pkg *packages.Package, call *ast.CallExpr) {
+	info := pkg.TypesInfo
+	selector, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	method, ok := routeMethods[selector.Sel.Name]
+	if !ok || len(call.Args) < 3 || !isNamed(info.TypeOf(selector.X), swaggerPkgPath, "SwaggerRouter") {
+		return
+	}
+	// documented routes & typed handlers (which derive their docs) are left alone
+	if tv, ok := info.Types[call.Args[1]]; !ok || !tv.IsNil() {
+		return
+	}
+	handler := call.Args[2]
+	if isNamed(info.TypeOf(handler), swaggerPkgPath, "TypedHandler") {
+		return
+	}
+
+	position := pkg.Fset.Position(call.Pos())
+	path, ok := constantString(info, call.Args[0])
+	if !ok {
+		a.warn(position, "skipping the route, it's path is not a constant")
+		return
+	}
+	prefix, ok := a.routerPrefix(info, selector.X)
+	if !ok {
+		a.warn(position, "skipping %s %s, unable to resolve the group prefix of the router", method, path)
+		return
+	}
+
+	route := &analyzedRoute{
+		Method:   method,
+		Path:     prefix + path,
+		Position: position,
+		Handler:  types.ExprString(handler),
+	}
+	if _, ok := handler.(*ast.FuncLit); ok {
+		route.Handler = "func literal"
+	}
+	a.analyzeHandler(info, handler, route, map[*types.Func]bool{})
+	if route.isEmpty() {
+		return
+	}
+	a.routes = append(a.routes, route)
+}
+
+func (a *analyzer) analyzeHandler(info *types.Info, handler ast.Expr, route *analyzedRoute, visited map[*types.Func]bool) {
+	switch handler := ast.Unparen(handler).(type) {
+	case *ast.FuncLit:
+		a.analyzeBody(info, handler.Body, route, visited)
+	case *ast.Ident, *ast.SelectorExpr:
+		// function / method values
+		var obj types.Object
+		if selector, ok := handler.(*ast.SelectorExpr); ok {
+			obj = info.ObjectOf(selector.Sel)
+		} else {
+			obj = info.ObjectOf(handler.(*ast.Ident))
+		}
+		if fn, ok := obj.(*types.Func); ok {
+			a.analyzeFunc(fn, route, visited)
+		}
+	case *ast.CallExpr:
+		// handler factories (`handlers.List(db)`), the returned closures are a part of their body
+		if fn, ok := typeutil.Callee(info, handler).(*types.Func); ok {
+			a.analyzeFunc(fn, route, visited)
+		}
+	}
+}
+
+func (a *analyzer) analyzeFunc(fn *types.Func, route *analyzedRoute, visited map[*types.Func]bool) {
+	fn = fn.Origin()
+	source, ok := a.funcs[fn]
+	if !ok || visited[fn] {
+		return
+	}
+	visited[fn] = true
+	a.analyzeBody(source.info, source.body, route, visited)
+}
+
+func (a *analyzer) analyzeBody(info *types.Info, body *ast.BlockStmt, route *analyzedRoute, visited map[*types.Func]bool) {
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		fn, ok := typeutil.Callee(info, call).(*types.Func)
+		if !ok {
+			return true
+		}
+		if fn.Pkg() != nil && fn.Pkg().Path() == fiberPkgPath {
+			a.analyzeFiberCall(info, call, fn, route)
+			return true
+		}
+		// helpers receiving the context (`parsePagination(c)`)
+		for _, arg := range call.Args {
+			if isNamed(info.TypeOf(arg), fiberPkgPath, "Ctx") {
+				a.analyzeFunc(fn, route, visited)
+				break
+			}
+		}
+		return true
+	})
+}
+
+func (a *analyzer) analyzeFiberCall(info *types.Info, call *ast.CallExpr, fn *types.Func, route *analyzedRoute) {
+	receiver := fn.Type().(*types.Signature).Recv()
+
+	// generic helpers: fiber.Query[int](c, "page"), fiber.Params[int](c, "id"), fiber.GetReqHeader[int](c, "X-Id")
+	if receiver == nil {
+		in := map[string]string{"Query": "query", "Params": "path", "GetReqHeader": "header"}[fn.Name()]
+		if in == "" || len(call.Args) < 2 {
+			return
+		}
+		name, ok := constantString(info, call.Args[1])
+		if !ok {
+			return
+		}
+		var t types.Type
+		if instance, ok := info.Instances[calleeIdent(call.Fun)]; ok && instance.TypeArgs.Len() == 1 {
+			t = instance.TypeArgs.At(0)
+			if basic, ok := t.(*types.Basic); ok && basic.Kind() == types.String {
+				t = nil
+			}
+		}
+		// path parameters are added from the path itself, only their types are interesting
+		if in == "path" && t == nil {
+			return
+		}
+		route.addParameter(analyzedParameter{In: in, Name: name, Type: t})
+		return
+	}
+
+	selector, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	switch {
+	case isNamed(receiver.Type(), fiberPkgPath, "Ctx") || isNamed(receiver.Type(), fiberPkgPath, "DefaultCtx"):
+		switch fn.Name() {
+		case "Query", "Get", "Cookies":
+			if len(call.Args) == 0 {
+				return
+			}
+			if name, ok := constantString(info, call.Args[0]); ok {
+				in := map[string]string{"Query": "query", "Get": "header", "Cookies": "cookie"}[fn.Name()]
+				route.addParameter(analyzedParameter{In: in, Name: name})
+			}
+		case "JSON":
+			if len(call.Args) == 0 {
+				return
+			}
+			route.addResponse(analyzedResponse{Status: responseStatus(info, selector.X), Type: info.TypeOf(call.Args[0])})
+		case "Send", "SendString":
+			route.addResponse(analyzedResponse{Status: responseStatus(info, selector.X)})
+		case "SendStatus":
+			if len(call.Args) == 0 {
+				return
+			}
+			if status, ok := constantInt(info, call.Args[0]); ok {
+				route.addResponse(analyzedResponse{Status: status})
+			}
+		}
+
+	case isNamed(receiver.Type(), fiberPkgPath, "Bind"):
+		if len(call.Args) == 0 {
+			return
+		}
+		t := info.TypeOf(call.Args[0])
+		if pointer, ok := t.(*types.Pointer); ok {
+			t = pointer.Elem()
+		}
+		if helper, ok := bodyBinders[fn.Name()]; ok && route.RequestBody == nil {
+			route.RequestBody = t
+			route.RequestBodyFunc = helper
+		} else if parameterBinders[fn.Name()] {
+			route.addParametersFrom(t)
+		}
+	}
+}
+
+// status of the response, set using `c.Status(n)` before sending it
+func responseStatus(info *types.Info, ctx ast.Expr) int {
+	call, ok := ast.Unparen(ctx).(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return 200
+	}
+	fn, ok := typeutil.Callee(info, call).(*types.Func)
+	if !ok || fn.Name() != "Status" || fn.Pkg() == nil || fn.Pkg().Path() != fiberPkgPath {
+		return 200
+	}
+	if status, ok := constantInt(info, call.Args[0]); ok {
+		return status
+	}
+	return 200
+}
+
+func calleeIdent(fun ast.Expr) *ast.Ident {
+	switch fun := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		return fun
+	case *ast.SelectorExpr:
+		return fun.Sel
+	case *ast.IndexExpr:
+		return calleeIdent(fun.X)
+	case *ast.IndexListExpr:
+		return calleeIdent(fun.X)
+	}
+	return nil
+}
+
+func constantString(info *types.Info, expr ast.Expr) (string, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+func constantInt(info *types.Info, expr ast.Expr) (int, bool) {
+	tv, ok := info.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return 0, false
+	}
+	value, ok := constant.Int64Val(tv.Value)
+	return int(value), ok
+}
+
+// reports whether t (or the type it points to) is the named type pkg_path.name
+func isNamed(t types.Type, pkg_path string, name string) bool {
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Name() == name && obj.Pkg() != nil && obj.Pkg().Path() == pkg_path
+}
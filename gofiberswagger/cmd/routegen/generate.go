@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"go/types"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type generator struct {
+	pkg     *types.Package
+	dir     string
+	imports map[string]string // path -> name used inside the generated file
+	aliased map[string]bool   // paths imported under a different name than their package name
+	names   map[string]bool
+}
+
+func newGenerator(pkg *types.Package, dir string) *generator {
+	return &generator{
+		pkg:     pkg,
+		dir:     dir,
+		imports: map[string]string{swaggerPkgPath: "gofiberswagger"},
+		aliased: map[string]bool{},
+		names:   map[string]bool{"gofiberswagger": true},
+	}
+}
+
+func (g *generator) qualifier(pkg *types.Package) string {
+	if pkg == g.pkg {
+		return ""
+	}
+	if name, ok := g.imports[pkg.Path()]; ok {
+		return name
+	}
+	name := pkg.Name()
+	for i := 2; g.names[name]; i++ {
+		name = pkg.Name() + strconv.Itoa(i)
+	}
+	g.imports[pkg.Path()] = name
+	g.aliased[pkg.Path()] = name != pkg.Name()
+	g.names[name] = true
+	return name
+}
+
+// reports whether t can be referenced from the generated file
+func (g *generator) isReferenceable(t types.Type) bool {
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		return t.Kind() != types.UntypedNil && t.Kind() != types.Invalid
+	case *types.Pointer:
+		return g.isReferenceable(t.Elem())
+	case *types.Slice:
+		return g.isReferenceable(t.Elem())
+	case *types.Array:
+		return g.isReferenceable(t.Elem())
+	case *types.Map:
+		return g.isReferenceable(t.Key()) && g.isReferenceable(t.Elem())
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() == nil {
+			return obj.Name() != "error"
+		}
+		// types declared inside functions
+		if obj.Parent() != obj.Pkg().Scope() {
+			return false
+		}
+		if obj.Pkg() != g.pkg && (!obj.Exported() || obj.Pkg().Name() == "main") {
+			return false
+		}
+		for i := range t.TypeArgs().Len() {
+			if !g.isReferenceable(t.TypeArgs().At(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// returns the type the schema should be generated from (structs and slices / arrays / maps of them),
+// or nil when it's unknown / anonymous
+func (g *generator) schemaType(t types.Type) types.Type {
+	if t == nil {
+		return nil
+	}
+	for {
+		pointer, ok := types.Unalias(t).(*types.Pointer)
+		if !ok {
+			break
+		}
+		t = pointer.Elem()
+	}
+	switch unaliased := types.Unalias(t).(type) {
+	case *types.Named:
+		if _, ok := unaliased.Underlying().(*types.Struct); !ok {
+			return nil
+		}
+	case *types.Slice:
+		if g.schemaType(unaliased.Elem()) == nil {
+			return nil
+		}
+	case *types.Array:
+		if g.schemaType(unaliased.Elem()) == nil {
+			return nil
+		}
+	case *types.Map:
+		if basic, ok := unaliased.Key().Underlying().(*types.Basic); !ok || basic.Kind() != types.String || g.schemaType(unaliased.Elem()) == nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	if !g.isReferenceable(t) {
+		return nil
+	}
+	return t
+}
+
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, g.qualifier)
+}
+
+func (g *generator) generate(routes []*analyzedRoute) ([]byte, error) {
+	body := bytes.Buffer{}
+	for _, route := range routes {
+		position := route.Position.Filename
+		if relative, err := filepath.Rel(g.dir, position); err == nil {
+			position = filepath.ToSlash(relative)
+		}
+		body.WriteString("// " + position + ":" + strconv.Itoa(route.Position.Line) + " (" + route.Handler + ")\n")
+		body.WriteString("gofiberswagger.RegisterRoute(" + strconv.Quote(route.Method) + ", " + strconv.Quote(route.Path) + ", &gofiberswagger.RouteInfo{\n")
+		g.writeParameters(&body, route)
+		if t := g.schemaType(route.RequestBody); t != nil {
+			body.WriteString("RequestBody: gofiberswagger." + route.RequestBodyFunc + "[" + g.typeString(t) + "](),\n")
+		}
+		g.writeResponses(&body, route)
+		body.WriteString("})\n")
+	}
+
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	source := bytes.Buffer{}
+	source.WriteString("// Code generated by gofiberswagger/cmd/routegen. DO NOT EDIT.\n\n")
+	source.WriteString("package " + g.pkg.Name() + "\n\n")
+	source.WriteString("import (\n")
+	for _, path := range paths {
+		if g.aliased[path] {
+			source.WriteString(g.imports[path] + " ")
+		}
+		source.WriteString(strconv.Quote(path) + "\n")
+	}
+	source.WriteString(")\n\n")
+	source.WriteString("func init() {\n")
+	source.Write(body.Bytes())
+	source.WriteString("}\n")
+
+	return format.Source(source.Bytes())
+}
+
+func (g *generator) writeParameters(body *bytes.Buffer, route *analyzedRoute) {
+	parameters := []string{}
+	for _, parameter := range route.Parameters {
+		helper := strings.ToUpper(parameter.In[:1]) + parameter.In[1:] + "Parameter"
+		if parameter.Type != nil && g.isReferenceable(parameter.Type) {
+			parameters = append(parameters, "gofiberswagger.INew"+helper+"["+g.typeString(parameter.Type)+"]("+strconv.Quote(parameter.Name)+")")
+		} else {
+			parameters = append(parameters, "gofiberswagger.New"+helper+"("+strconv.Quote(parameter.Name)+")")
+		}
+	}
+	from := []string{}
+	for _, t := range route.ParametersFrom {
+		if _, ok := types.Unalias(g.schemaType(t)).(*types.Named); ok {
+			from = append(from, "gofiberswagger.NewParametersFrom["+g.typeString(g.schemaType(t))+"]()")
+		}
+	}
+	if len(parameters) == 0 && len(from) == 0 {
+		return
+	}
+
+	expr := "gofiberswagger.NewParameters(\n" + strings.Join(parameters, ",\n") + ",\n)"
+	if len(parameters) == 0 {
+		expr, from = from[0], from[1:]
+	}
+	for _, parameters_from := range from {
+		expr = "append(" + expr + ", " + parameters_from + "...)"
+	}
+	body.WriteString("Parameters: " + expr + ",\n")
+}
+
+func (g *generator) writeResponses(body *bytes.Buffer, route *analyzedRoute) {
+	if len(route.Responses) == 0 {
+		return
+	}
+	responses := slices.Clone(route.Responses)
+	slices.SortStableFunc(responses, func(a analyzedResponse, b analyzedResponse) int {
+		return a.Status - b.Status
+	})
+
+	body.WriteString("Responses: gofiberswagger.NewResponses(\n")
+	for _, response := range responses {
+		code := strconv.Quote(strconv.Itoa(response.Status))
+		description := http.StatusText(response.Status)
+		if description == "" {
+			description = "Status " + strconv.Itoa(response.Status)
+		}
+		if t := g.schemaType(response.Type); t != nil {
+			body.WriteString("gofiberswagger.NewResponseInfo[" + g.typeString(t) + "](" + code + ", " + strconv.Quote(description) + "),\n")
+		} else {
+			body.WriteString("gofiberswagger.NewResponseInfoNoContent(" + code + ", " + strconv.Quote(description) + "),\n")
+		}
+	}
+	body.WriteString("),\n")
+}
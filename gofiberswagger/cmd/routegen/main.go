@@ -0,0 +1,100 @@
+// Command routegen infers a baseline documentation of the routes registered through gofiberswagger.SwaggerRouter
+// without docs (`router.Get("/users", nil, handler)`), by statically analyzing their handlers:
+//
+//   - c.Query("page"), c.Get("X-Request-Id"), c.Cookies("session") and fiber.Query[int](c, "page") -> parameters
+//   - c.Bind().Query(&Params{}) (as well as Header, Cookie, URI and All) -> parameters (gofiberswagger.NewParametersFrom)
+//   - c.Bind().Body(&Request{}) (as well as JSON, XML, Form, ...) -> request body
+//   - c.JSON(Response{}), c.Status(404).JSON(ErrorResponse{}), c.SendStatus(204) -> responses
+//
+// Handlers are followed through function / method values, handler factories and helpers receiving the fiber.Ctx.
+// The result is written as a go file registering the inferred docs using gofiberswagger.RegisterRoute (DefaultRegistry),
+// which are kept by SwaggerRouter as long as the route is registered with `nil` docs. Once you document the route,
+// the generated docs are ignored.
+//
+// Add the following line to the package registering the routes (e.g. main.go) and run `go generate`:
+//
+//	//go:generate go run github.com/TDiblik/gofiber-swagger/gofiberswagger/cmd/routegen@latest
+//
+// Usage:
+//
+//	routegen [-dir .] [-o gofiberswagger_routes.go] [packages]
+//
+// The packages (default: the package inside dir) are analyzed for routes & handlers, the output gets written
+// into the package inside dir.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/tools/go/packages"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package the output gets written into")
+	output := flag.String("o", "gofiberswagger_routes.go", "output file")
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	source, warnings, err := run(*dir, *output, patterns)
+	for _, warning := range warnings {
+		log.Println("routegen:", warning)
+	}
+	if err != nil {
+		log.Fatalln("routegen:", err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), source, 0o644); err != nil {
+		log.Fatalln("routegen:", err)
+	}
+}
+
+func run(dir string, output string, patterns []string) ([]byte, []string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedImports,
+		Dir:  dir,
+		// the previously generated file must not break the analysis, when it doesn't compile anymore
+		ParseFile: func(file_set *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			mode := parser.AllErrors | parser.ParseComments
+			if filename == filepath.Join(dir, output) {
+				mode = parser.PackageClauseOnly
+			}
+			return parser.ParseFile(file_set, filename, src, mode)
+		},
+	}, patterns...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, nil, fmt.Errorf("unable to load the packages")
+	}
+
+	var output_pkg *packages.Package
+	for _, pkg := range pkgs {
+		for _, file := range pkg.GoFiles {
+			if filepath.Dir(file) == dir {
+				output_pkg = pkg
+			}
+		}
+	}
+	if output_pkg == nil {
+		return nil, nil, fmt.Errorf("no package found inside %q", dir)
+	}
+
+	a := newAnalyzer(pkgs)
+	routes := a.analyze()
+	source, err := newGenerator(output_pkg.Types, dir).generate(routes)
+	return source, a.warnings, err
+}
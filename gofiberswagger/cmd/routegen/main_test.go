@@ -0,0 +1,22 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	source, warnings, err := run("testdata/app", "gofiberswagger_routes.go", []string{"."})
+	assert.NoError(t, err)
+
+	expected, err := os.ReadFile("testdata/app/gofiberswagger_routes.go")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(source))
+
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "skipping GET /health, unable to resolve the group prefix of the router")
+}
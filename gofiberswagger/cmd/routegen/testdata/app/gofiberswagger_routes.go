@@ -0,0 +1,46 @@
+// Code generated by gofiberswagger/cmd/routegen. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/TDiblik/gofiber-swagger/gofiberswagger"
+)
+
+func init() {
+	// main.go:31 (func literal)
+	gofiberswagger.RegisterRoute("GET", "/health", &gofiberswagger.RouteInfo{
+		Responses: gofiberswagger.NewResponses(
+			gofiberswagger.NewResponseInfoNoContent("204", "No Content"),
+		),
+	})
+	// main.go:51 (handlers.List)
+	gofiberswagger.RegisterRoute("GET", "/api/users/", &gofiberswagger.RouteInfo{
+		Parameters: append(gofiberswagger.NewParameters(
+			gofiberswagger.INewQueryParameter[int]("page"),
+			gofiberswagger.INewQueryParameter[int]("limit"),
+			gofiberswagger.NewHeaderParameter("X-Request-Id"),
+		), gofiberswagger.NewParametersFrom[ListUsersParams]()...),
+		Responses: gofiberswagger.NewResponses(
+			gofiberswagger.NewResponseInfo[[]User]("200", "OK"),
+		),
+	})
+	// main.go:52 (createUser())
+	gofiberswagger.RegisterRoute("POST", "/api/users/", &gofiberswagger.RouteInfo{
+		RequestBody: gofiberswagger.NewRequestBody[CreateUserRequest](),
+		Responses: gofiberswagger.NewResponses(
+			gofiberswagger.NewResponseInfo[User]("201", "Created"),
+			gofiberswagger.NewResponseInfo[ErrorResponse]("400", "Bad Request"),
+		),
+	})
+	// main.go:53 (getUser)
+	gofiberswagger.RegisterRoute("GET", "/api/users/:id", &gofiberswagger.RouteInfo{
+		Parameters: gofiberswagger.NewParameters(
+			gofiberswagger.INewPathParameter[int]("id"),
+			gofiberswagger.NewCookieParameter("session"),
+		),
+		Responses: gofiberswagger.NewResponses(
+			gofiberswagger.NewResponseInfo[User]("200", "OK"),
+			gofiberswagger.NewResponseInfoNoContent("404", "Not Found"),
+		),
+	})
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"github.com/TDiblik/gofiber-swagger/gofiberswagger"
+	"github.com/gofiber/fiber/v3"
+)
+
+type User struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type CreateUserRequest struct {
+	Name string `json:"name"`
+}
+
+type ListUsersParams struct {
+	Roles []string `query:"roles"`
+}
+
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+type Handlers struct{}
+
+func main() {
+	app := fiber.New()
+	router := gofiberswagger.NewRouter(app)
+
+	router.Get("/health", nil, func(c fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+	router.Get("/documented", &gofiberswagger.RouteInfo{}, func(c fiber.Ctx) error {
+		return c.JSON(User{})
+	})
+	router.Get("/typed", nil, gofiberswagger.Handle(func(c fiber.Ctx, req CreateUserRequest) (User, error) {
+		return User{}, nil
+	}))
+
+	api := router.Group("/api")
+	registerUserRoutes(api.Group("/users"), &Handlers{})
+	registerHealth(api.Group("/v1"))
+	registerHealth(api.Group("/v2"))
+
+	gofiberswagger.Register(app, &gofiberswagger.DefaultConfig)
+	app.Listen(":3000")
+}
+
+func registerUserRoutes(router gofiberswagger.SwaggerRouter, handlers *Handlers) {
+	router.Get("/", nil, handlers.List)
+	router.Post("/", nil, createUser())
+	router.Get("/:id", nil, getUser)
+}
+
+// called with different groups, the prefix can't be resolved
+func registerHealth(router gofiberswagger.SwaggerRouter) {
+	router.Get("/health", nil, func(c fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+}
+
+func (h *Handlers) List(c fiber.Ctx) error {
+	params := ListUsersParams{}
+	if err := c.Bind().Query(&params); err != nil {
+		return err
+	}
+	page, limit := pagination(c)
+	_ = c.Get("X-Request-Id")
+	return c.JSON(listUsers(page, limit))
+}
+
+func createUser() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		request := new(CreateUserRequest)
+		if err := c.Bind().Body(request); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{Message: err.Error()})
+		}
+		return c.Status(fiber.StatusCreated).JSON(&User{Name: request.Name})
+	}
+}
+
+func getUser(c fiber.Ctx) error {
+	type localError struct{ Message string }
+
+	id := fiber.Params[int](c, "id")
+	if id == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(localError{Message: "not found"})
+	}
+	_ = c.Cookies("session")
+	return c.JSON(User{ID: id})
+}
+
+func pagination(c fiber.Ctx) (int, int) {
+	return fiber.Query[int](c, "page", 1), fiber.Query[int](c, "limit", 10)
+}
+
+func listUsers(page int, limit int) []User {
+	return []User{}
+}
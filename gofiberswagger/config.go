@@ -1,6 +1,11 @@
 package gofiberswagger
 
-import "github.com/getkin/kin-openapi/openapi3"
+import (
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+)
 
 // https://github.com/OAI/OpenAPI-Specification/blob/main/versions/3.1.1.md#openapi-object
 type SwaggerConfig = openapi3.T
@@ -15,6 +20,82 @@ type Config struct {
 	RequiredAuth             *openapi3.SecurityRequirements
 	AutomaticallyRequireAuth bool
 	CallbackBeforeGenerate   func(config *Config) error
+
+	// Path the docs (UI & generated files) get served at.
+	// default: "/swagger"
+	BasePath string
+	// Name of the UI page. The UI is served at both BasePath and BasePath + "/" + IndexFileName.
+	// default: "index.html"
+	IndexFileName string
+	// Name of the generated json file.
+	// default: "swagger.json"
+	JSONFileName string
+	// Name of the generated yaml file. SwaggerUI.URL gets derived from it, unless set explicitly.
+	// default: "swagger.yaml"
+	YAMLFileName string
+
+	// Router the docs get served on (e.g. a separate admin app, or a group protected by basic auth).
+	// Its prefix (group prefix / mount path) is taken into account when deriving SwaggerUI.URL.
+	// default: nil -> the documented app
+	DocsRouter fiber.Router
+	// Middleware applied only to the docs routes.
+	// default: nil
+	DocsMiddleware []any
+
+	// Renderer of the docs page (e.g. &ReDocConfig{}, &ScalarConfig{}, ...).
+	// default: nil -> Swagger UI configured by SwaggerUI
+	Renderer Renderer
+	// Renderers served side by side with the docs page, keyed by their sub-path
+	// (e.g. {"redoc": ReDocConfig{}} gets served at BasePath + "/redoc").
+	// default: nil
+	AdditionalRenderers map[string]Renderer
+
+	// Fills empty operation summaries / descriptions from the doc comments of the handlers
+	// and schema / property descriptions from the doc comments of the types and their fields.
+	// The comments are read from the sources, or from the index registered by RegisterDocComments
+	// (see cmd/docindex) for binaries shipped without their sources.
+	// default: false
+	UseDocComments bool
+
+	// Names the component schemas generated for named struct types (SchemaNamingFullPath, SchemaNamingPackage,
+	// SchemaNamingShort or a custom func). Collisions get resolved when the document gets generated, independently
+	// of the order of the types (see SchemaNamingStrategy). It's read from the Config of the registry generating
+	// the schemas (DefaultRegistry.Config for the package level helpers like NewRequestBody[T]), set it before
+	// any schema gets created.
+	// default: SchemaNamingFullPath
+	SchemaNaming SchemaNamingStrategy
+
+	// Prefixes the names of the documentation tags (doc, example, default, format, pattern, deprecated,
+	// readonly & writeonly) to avoid clashes with other libraries, e.g. "oas_" for `oas_example:"..."`.
+	// Read from the Config of the registry generating the schemas, the same way as SchemaNaming.
+	// default: ""
+	TagPrefix string
+
+	// Derives the operationIds of the operations without one (OperationIDFromRouteName, OperationIDFromHandler,
+	// OperationIDFromMethodAndPath, OperationIDAuto or a custom func). Duplicate operationIds make
+	// Register / GenerateHandlers fail.
+	// default: nil -> no generated operationIds
+	OperationIDStrategy OperationIDStrategy
+	// Casing of the generated operationIds (OperationIDCamelCase, OperationIDPascalCase, OperationIDSnakeCase,
+	// OperationIDKebabCase or a custom func).
+	// default: OperationIDCamelCase
+	OperationIDCasing OperationIDCasing
+
+	// Validates the generated document against the OpenAPI specification (duplicate operationIds, undeclared
+	// path parameters, unresolvable $refs, missing response descriptions, ...). Register / GenerateHandlers
+	// fail for invalid documents.
+	// default: false
+	ValidateSpec bool
+	// Severities of the lint rules keyed by their names (LintRuleMissingSummary, ... and the names of LintRules).
+	// Rules which are not listed are off.
+	// default: nil -> no linting
+	Lint map[string]LintSeverity
+	// Custom lint rules, checked after the built-in ones (BuiltinLintRules).
+	// default: nil
+	LintRules []LintRule
+	// Called with all the issues found by the linter, e.g. to report them elsewhere.
+	// default: nil -> the LintSeverityWarning issues get logged
+	OnLintIssues func(issues []LintIssue)
 }
 
 var DefaultSwaggerConfig = SwaggerConfig{
@@ -36,11 +117,66 @@ var DefaultConfig = Config{
 	RequiredAuth:             nil,
 	AutomaticallyRequireAuth: false,
 	CallbackBeforeGenerate:   nil,
+	BasePath:                 "/swagger",
+	IndexFileName:            "index.html",
+	JSONFileName:             "swagger.json",
+	YAMLFileName:             "swagger.yaml",
+}
+
+func configDefault(config *Config) {
+	if config.BasePath == "" {
+		config.BasePath = DefaultConfig.BasePath
+	}
+	config.BasePath = "/" + strings.Trim(config.BasePath, "/")
+
+	if config.IndexFileName == "" {
+		config.IndexFileName = DefaultConfig.IndexFileName
+	}
+	if config.JSONFileName == "" {
+		config.JSONFileName = DefaultConfig.JSONFileName
+	}
+	if config.YAMLFileName == "" {
+		config.YAMLFileName = DefaultConfig.YAMLFileName
+	}
+
+	config.Swagger = swaggerConfigDefault(config.Swagger)
+	config.SwaggerUI = swaggerUIConfigDefault(config.SwaggerUI)
+	docs_path := getDocsPath(config)
+	if config.SwaggerUI.URL == "" {
+		config.SwaggerUI.URL = getSpecURL(config)
+	}
+	if config.SwaggerUI.AssetsURL == "" && config.SwaggerUI.AssetsSource == AssetsSourceEmbedded {
+		config.SwaggerUI.AssetsURL = docs_path
+	}
+}
+
+// path the docs are served at, including the prefix of the DocsRouter
+func getDocsPath(config *Config) string {
+	return strings.TrimSuffix(getRouterPrefix(config.DocsRouter)+config.BasePath, "/")
+}
+
+// url of the generated yaml spec
+func getSpecURL(config *Config) string {
+	return getDocsPath(config) + "/" + config.YAMLFileName
+}
+
+func getRouterPrefix(router fiber.Router) string {
+	switch router := router.(type) {
+	case *fiber.Group:
+		return strings.TrimSuffix(router.Prefix, "/")
+	case *fiber.App:
+		return strings.TrimSuffix(router.MountPath(), "/")
+	}
+	return ""
 }
 
 func swaggerConfigDefault(config SwaggerConfig) SwaggerConfig {
 	cfg := config
 
+	// the version decides the constructs used by the generated schemas (see isOpenAPI31)
+	if cfg.OpenAPI == "" {
+		cfg.OpenAPI = DefaultSwaggerConfig.OpenAPI
+	}
 	if cfg.Info == nil {
 		cfg.Info = DefaultSwaggerConfig.Info
 	}
@@ -51,16 +187,25 @@ func swaggerConfigDefault(config SwaggerConfig) SwaggerConfig {
 		cfg.Info.Version = DefaultSwaggerConfig.Info.Version
 	}
 
-	if cfg.Paths == nil {
-		cfg.Paths = DefaultSwaggerConfig.Paths
+	// copy paths and components, so that multiple registrations (e.g. multiple registries using the DefaultConfig) don't share them
+	paths := &Paths{}
+	if cfg.Paths != nil {
+		for path, path_item := range cfg.Paths.Map() {
+			paths.Set(path, path_item)
+		}
 	}
+	cfg.Paths = paths
 
-	if cfg.Components == nil {
-		cfg.Components = DefaultSwaggerConfig.Components
+	components := Components{}
+	if cfg.Components != nil {
+		components = *cfg.Components
 	}
-	if cfg.Components.Schemas == nil {
-		cfg.Components.Schemas = make(map[string]*SchemaRef)
+	schemas := make(Schemas, len(components.Schemas))
+	for name, schema := range components.Schemas {
+		schemas[name] = schema
 	}
+	components.Schemas = schemas
+	cfg.Components = &components
 
 	return cfg
 }
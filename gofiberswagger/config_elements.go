@@ -0,0 +1,110 @@
+package gofiberswagger
+
+// StoplightElementsConfig stores Stoplight Elements (https://github.com/stoplightio/elements) configuration variables
+type StoplightElementsConfig struct {
+	// Title pointing to title of HTML page.
+	// default: "Stoplight Elements"
+	Title string
+
+	// The URL pointing to API definition.
+	// default: "" -> derived from Config.BasePath and Config.YAMLFileName
+	SpecURL string
+
+	// URL of the Stoplight Elements web components bundle.
+	// default: "https://unpkg.com/@stoplight/elements/web-components.min.js"
+	ScriptURL string
+
+	// URL of the Stoplight Elements styles.
+	// default: "https://unpkg.com/@stoplight/elements/styles.min.css"
+	StyleURL string
+
+	// Determines how navigation should work. Possible values are ["history", "hash", "memory", "static"]
+	// default: "hash"
+	Router string
+
+	// Layout of the page. Possible values are ["sidebar", "stacked", "responsive"]
+	// default: "sidebar"
+	Layout string
+
+	// URL of an image shown as a logo in the top-left corner.
+	// default: ""
+	Logo string
+
+	// Hides the "Try It" panel.
+	// default: false
+	HideTryIt bool
+
+	// Hides the schemas in the table of contents.
+	// default: false
+	HideSchemas bool
+
+	// Hides the "Export" button.
+	// default: false
+	HideExport bool
+}
+
+var DefaultStoplightElementsConfig = StoplightElementsConfig{
+	Title:     "Stoplight Elements",
+	ScriptURL: "https://unpkg.com/@stoplight/elements/web-components.min.js",
+	StyleURL:  "https://unpkg.com/@stoplight/elements/styles.min.css",
+	Router:    "hash",
+	Layout:    "sidebar",
+}
+
+func stoplightElementsConfigDefault(elements_config StoplightElementsConfig) StoplightElementsConfig {
+	cfg := elements_config
+
+	if cfg.Title == "" {
+		cfg.Title = DefaultStoplightElementsConfig.Title
+	}
+
+	if cfg.ScriptURL == "" {
+		cfg.ScriptURL = DefaultStoplightElementsConfig.ScriptURL
+	}
+
+	if cfg.StyleURL == "" {
+		cfg.StyleURL = DefaultStoplightElementsConfig.StyleURL
+	}
+
+	if cfg.Router == "" {
+		cfg.Router = DefaultStoplightElementsConfig.Router
+	}
+
+	if cfg.Layout == "" {
+		cfg.Layout = DefaultStoplightElementsConfig.Layout
+	}
+
+	return cfg
+}
+
+func (elements_config StoplightElementsConfig) Render(spec_url string) ([]byte, error) {
+	cfg := stoplightElementsConfigDefault(elements_config)
+	if cfg.SpecURL == "" {
+		cfg.SpecURL = spec_url
+	}
+	return renderTemplate("elements_index.html", elementsPageTmpl, cfg)
+}
+
+const elementsPageTmpl string = `
+<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>{{.Title}}</title>
+    <script src="{{.ScriptURL}}"></script>
+    <link rel="stylesheet" href="{{.StyleURL}}">
+  </head>
+  <body>
+    <elements-api
+      apiDescriptionUrl="{{.SpecURL}}"
+      router="{{.Router}}"
+      layout="{{.Layout}}"
+      {{- if .Logo}} logo="{{.Logo}}"{{end}}
+      {{- if .HideTryIt}} hideTryIt="true"{{end}}
+      {{- if .HideSchemas}} hideSchemas="true"{{end}}
+      {{- if .HideExport}} hideExport="true"{{end}}
+    ></elements-api>
+  </body>
+</html>
+`
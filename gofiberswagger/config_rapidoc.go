@@ -0,0 +1,125 @@
+package gofiberswagger
+
+// RapiDocConfig stores RapiDoc (https://github.com/rapi-doc/RapiDoc) configuration variables
+type RapiDocConfig struct {
+	// Title pointing to title of HTML page.
+	// default: "RapiDoc"
+	Title string
+
+	// The URL pointing to API definition.
+	// default: "" -> derived from Config.BasePath and Config.YAMLFileName
+	SpecURL string
+
+	// URL of the RapiDoc bundle.
+	// default: "https://unpkg.com/rapidoc/dist/rapidoc-min.js"
+	ScriptURL string
+
+	// Heading text shown in the header.
+	// default: ""
+	HeadingText string
+
+	// Theme of the page. Possible values are ["light", "dark"]
+	// default: "light"
+	Theme string
+
+	// Determines display of api-docs. Possible values are ["read", "view", "focused"]
+	// default: "read"
+	RenderStyle string
+
+	// Layout helps in placement of request/response sections. Possible values are ["row", "column"]
+	// default: "row"
+	Layout string
+
+	// Two different ways to display object-schemas in the responses and request bodies. Possible values are ["tree", "table"]
+	// default: "tree"
+	SchemaStyle string
+
+	// Hex color code of the primary color.
+	// default: ""
+	PrimaryColor string
+
+	// Hex color code of the background color.
+	// default: ""
+	BgColor string
+
+	// Hides the header.
+	// default: false
+	HideHeader bool
+
+	// Disables the "Try" feature.
+	// default: false
+	DisableTry bool
+}
+
+var DefaultRapiDocConfig = RapiDocConfig{
+	Title:       "RapiDoc",
+	ScriptURL:   "https://unpkg.com/rapidoc/dist/rapidoc-min.js",
+	Theme:       "light",
+	RenderStyle: "read",
+	Layout:      "row",
+	SchemaStyle: "tree",
+}
+
+func rapidocConfigDefault(rapidoc_config RapiDocConfig) RapiDocConfig {
+	cfg := rapidoc_config
+
+	if cfg.Title == "" {
+		cfg.Title = DefaultRapiDocConfig.Title
+	}
+
+	if cfg.ScriptURL == "" {
+		cfg.ScriptURL = DefaultRapiDocConfig.ScriptURL
+	}
+
+	if cfg.Theme == "" {
+		cfg.Theme = DefaultRapiDocConfig.Theme
+	}
+
+	if cfg.RenderStyle == "" {
+		cfg.RenderStyle = DefaultRapiDocConfig.RenderStyle
+	}
+
+	if cfg.Layout == "" {
+		cfg.Layout = DefaultRapiDocConfig.Layout
+	}
+
+	if cfg.SchemaStyle == "" {
+		cfg.SchemaStyle = DefaultRapiDocConfig.SchemaStyle
+	}
+
+	return cfg
+}
+
+func (rapidoc_config RapiDocConfig) Render(spec_url string) ([]byte, error) {
+	cfg := rapidocConfigDefault(rapidoc_config)
+	if cfg.SpecURL == "" {
+		cfg.SpecURL = spec_url
+	}
+	return renderTemplate("rapidoc_index.html", rapidocPageTmpl, cfg)
+}
+
+const rapidocPageTmpl string = `
+<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>{{.Title}}</title>
+    <script type="module" src="{{.ScriptURL}}"></script>
+  </head>
+  <body>
+    <rapi-doc
+      spec-url="{{.SpecURL}}"
+      theme="{{.Theme}}"
+      render-style="{{.RenderStyle}}"
+      layout="{{.Layout}}"
+      schema-style="{{.SchemaStyle}}"
+      {{- if .HeadingText}} heading-text="{{.HeadingText}}"{{end}}
+      {{- if .PrimaryColor}} primary-color="{{.PrimaryColor}}"{{end}}
+      {{- if .BgColor}} bg-color="{{.BgColor}}"{{end}}
+      {{- if .HideHeader}} show-header="false"{{end}}
+      {{- if .DisableTry}} allow-try="false"{{end}}
+    ></rapi-doc>
+  </body>
+</html>
+`
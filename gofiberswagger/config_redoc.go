@@ -0,0 +1,104 @@
+package gofiberswagger
+
+// ReDocConfig stores ReDoc (https://github.com/Redocly/redoc) configuration variables
+type ReDocConfig struct {
+	// Title pointing to title of HTML page.
+	// default: "ReDoc"
+	Title string `json:"-"`
+
+	// The URL pointing to API definition.
+	// default: "" -> derived from Config.BasePath and Config.YAMLFileName
+	SpecURL string `json:"-"`
+
+	// URL of the ReDoc standalone bundle.
+	// default: "https://cdn.redoc.ly/redoc/latest/bundles/redoc.standalone.js"
+	ScriptURL string `json:"-"`
+
+	// Do not show the "Download" spec button.
+	// default: false
+	HideDownloadButton bool `json:"hideDownloadButton,omitempty"`
+
+	// Disable search indexing and search box.
+	// default: false
+	DisableSearch bool `json:"disableSearch,omitempty"`
+
+	// Specify which responses to expand by default by response codes. Values should be passed as comma-separated list without spaces e.g. "200,201". Special value "all" expands all responses by default.
+	// default: ""
+	ExpandResponses string `json:"expandResponses,omitempty"`
+
+	// If set, the protocol and hostname is not shown in the operation definition.
+	// default: false
+	HideHostname bool `json:"hideHostname,omitempty"`
+
+	// Use native scrollbar for sidemenu instead of perfect-scroll (scrolling performance optimization for big specs).
+	// default: false
+	NativeScrollbars bool `json:"nativeScrollbars,omitempty"`
+
+	// Show path link and HTTP verb in the middle panel instead of the right one.
+	// default: false
+	PathInMiddlePanel bool `json:"pathInMiddlePanel,omitempty"`
+
+	// Show required properties first ordered in the same order as in required array.
+	// default: false
+	RequiredPropsFirst bool `json:"requiredPropsFirst,omitempty"`
+
+	// Sort properties alphabetically.
+	// default: false
+	SortPropsAlphabetically bool `json:"sortPropsAlphabetically,omitempty"`
+
+	// If set, the spec is considered untrusted and all HTML/markdown is sanitized to prevent XSS.
+	// default: false
+	SanitizeSpec bool `json:"sanitize,omitempty"`
+
+	// ReDoc theme, see https://redocly.com/docs/redoc/config#theme-settings
+	// default: nil
+	Theme map[string]any `json:"theme,omitempty"`
+}
+
+var DefaultReDocConfig = ReDocConfig{
+	Title:     "ReDoc",
+	ScriptURL: "https://cdn.redoc.ly/redoc/latest/bundles/redoc.standalone.js",
+}
+
+func redocConfigDefault(redoc_config ReDocConfig) ReDocConfig {
+	cfg := redoc_config
+
+	if cfg.Title == "" {
+		cfg.Title = DefaultReDocConfig.Title
+	}
+
+	if cfg.ScriptURL == "" {
+		cfg.ScriptURL = DefaultReDocConfig.ScriptURL
+	}
+
+	return cfg
+}
+
+func (redoc_config ReDocConfig) Render(spec_url string) ([]byte, error) {
+	cfg := redocConfigDefault(redoc_config)
+	if cfg.SpecURL == "" {
+		cfg.SpecURL = spec_url
+	}
+	return renderTemplate("redoc_index.html", redocPageTmpl, cfg)
+}
+
+const redocPageTmpl string = `
+<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>{{.Title}}</title>
+    <style>
+      body { margin: 0; padding: 0; }
+    </style>
+  </head>
+  <body>
+    <div id="redoc-container"></div>
+    <script src="{{.ScriptURL}}"></script>
+    <script>
+      Redoc.init({{.SpecURL}}, {{.}}, document.getElementById('redoc-container'));
+    </script>
+  </body>
+</html>
+`
@@ -0,0 +1,108 @@
+package gofiberswagger
+
+import "html/template"
+
+// ScalarConfig stores Scalar API Reference (https://github.com/scalar/scalar) configuration variables
+type ScalarConfig struct {
+	// Title pointing to title of HTML page.
+	// default: "Scalar API Reference"
+	Title string `json:"-"`
+
+	// The URL pointing to API definition.
+	// default: "" -> derived from Config.BasePath and Config.YAMLFileName
+	SpecURL string `json:"url"`
+
+	// URL of the Scalar API Reference bundle.
+	// default: "https://cdn.jsdelivr.net/npm/@scalar/api-reference"
+	ScriptURL string `json:"-"`
+
+	// Theme of the page. Possible values are ["default", "alternate", "moon", "purple", "solarized", "bluePlanet", "saturn", "kepler", "mars", "deepSpace", "none"]
+	// default: ""
+	Theme string `json:"theme,omitempty"`
+
+	// Layout of the page. Possible values are ["modern", "classic"]
+	// default: ""
+	Layout string `json:"layout,omitempty"`
+
+	// Whether dark mode is on or off initially.
+	// default: false
+	DarkMode bool `json:"darkMode,omitempty"`
+
+	// Hides the models section.
+	// default: false
+	HideModels bool `json:"hideModels,omitempty"`
+
+	// Hides the download button for the spec.
+	// default: false
+	HideDownloadButton bool `json:"hideDownloadButton,omitempty"`
+
+	// Hides the "Test Request" button.
+	// default: false
+	HideTestRequestButton bool `json:"hideTestRequestButton,omitempty"`
+
+	// Hides the search bar in the sidebar.
+	// default: false
+	HideSearch bool `json:"hideSearch,omitempty"`
+
+	// URL of a proxy used to avoid CORS issues when sending requests.
+	// default: ""
+	ProxyURL string `json:"proxyUrl,omitempty"`
+
+	// Key used with CTRL/CMD to open the search modal.
+	// default: ""
+	SearchHotKey string `json:"searchHotKey,omitempty"`
+
+	// Applies custom CSS styles.
+	// default: ""
+	CustomStyle template.CSS `json:"-"`
+}
+
+var DefaultScalarConfig = ScalarConfig{
+	Title:     "Scalar API Reference",
+	ScriptURL: "https://cdn.jsdelivr.net/npm/@scalar/api-reference",
+}
+
+func scalarConfigDefault(scalar_config ScalarConfig) ScalarConfig {
+	cfg := scalar_config
+
+	if cfg.Title == "" {
+		cfg.Title = DefaultScalarConfig.Title
+	}
+
+	if cfg.ScriptURL == "" {
+		cfg.ScriptURL = DefaultScalarConfig.ScriptURL
+	}
+
+	return cfg
+}
+
+func (scalar_config ScalarConfig) Render(spec_url string) ([]byte, error) {
+	cfg := scalarConfigDefault(scalar_config)
+	if cfg.SpecURL == "" {
+		cfg.SpecURL = spec_url
+	}
+	return renderTemplate("scalar_index.html", scalarPageTmpl, cfg)
+}
+
+const scalarPageTmpl string = `
+<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+    <title>{{.Title}}</title>
+    {{- if .CustomStyle}}
+      <style>
+        {{.CustomStyle}}
+      </style>
+    {{- end}}
+  </head>
+  <body>
+    <div id="app"></div>
+    <script src="{{.ScriptURL}}"></script>
+    <script>
+      Scalar.createApiReference('#app', {{.}});
+    </script>
+  </body>
+</html>
+`
@@ -13,6 +13,7 @@ func TestSwaggerConfigDefault(t *testing.T) {
 	t.Run("empty config", func(t *testing.T) {
 		t.Parallel()
 		cfg := swaggerConfigDefault(SwaggerConfig{})
+		assert.Equal(t, DefaultSwaggerConfig.OpenAPI, cfg.OpenAPI)
 		assert.Equal(t, DefaultSwaggerConfig.Info.Title, cfg.Info.Title)
 		assert.Equal(t, DefaultSwaggerConfig.Info.Version, cfg.Info.Version)
 		assert.NotNil(t, cfg.Paths)
@@ -31,3 +32,26 @@ func TestSwaggerConfigDefault(t *testing.T) {
 		assert.Equal(t, DefaultSwaggerConfig.Info.Version, cfg.Info.Version)
 	})
 }
+
+func TestConfigDefault(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty config", func(t *testing.T) {
+		t.Parallel()
+		cfg := &Config{}
+		configDefault(cfg)
+		assert.Equal(t, "/swagger", cfg.BasePath)
+		assert.Equal(t, "index.html", cfg.IndexFileName)
+		assert.Equal(t, "swagger.json", cfg.JSONFileName)
+		assert.Equal(t, "swagger.yaml", cfg.YAMLFileName)
+		assert.Equal(t, "/swagger/swagger.yaml", cfg.SwaggerUI.URL)
+	})
+
+	t.Run("root base path", func(t *testing.T) {
+		t.Parallel()
+		cfg := &Config{BasePath: "/", YAMLFileName: "openapi.yaml"}
+		configDefault(cfg)
+		assert.Equal(t, "/", cfg.BasePath)
+		assert.Equal(t, "/openapi.yaml", cfg.SwaggerUI.URL)
+	})
+}
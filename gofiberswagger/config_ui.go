@@ -5,6 +5,8 @@ package gofiberswagger
 
 import (
 	"html/template"
+	"io/fs"
+	"strings"
 )
 
 // SwaggerUIConfig stores SwaggerUI configuration variables
@@ -17,12 +19,33 @@ type SwaggerUIConfig struct {
 	// default: "Swagger UI"
 	Title string `json:"-"`
 
+	// Where the swagger ui assets (js, css, favicons) are loaded from.
+	// AssetsSourceEmbedded serves them from AssetsFS next to the generated files, so the UI works offline.
+	// default: AssetsSourceCDN
+	AssetsSource SwaggerUIAssetsSource `json:"-"`
+
+	// Files of swagger-ui-dist served when AssetsSource is AssetsSourceEmbedded.
+	// default: EmbeddedSwaggerUIAssets
+	AssetsFS fs.FS `json:"-"`
+
+	// Version of swagger-ui-dist loaded from the CDN.
+	// default: "5.20.5"
+	Version string `json:"-"`
+
+	// URL of the CDN serving swagger-ui-dist. "{version}" gets replaced by Version.
+	// default: "https://unpkg.com/swagger-ui-dist@{version}"
+	CDNURL string `json:"-"`
+
+	// URL the assets are loaded from by the index page.
+	// default: "" -> derived from AssetsSource (CDNURL or the path the docs are served at)
+	AssetsURL string `json:"-"`
+
 	// URL to fetch external configuration document from.
 	// default: ""
 	ConfigURL string `json:"configUrl,omitempty"`
 
 	// The URL pointing to API definition (normally swagger.json or swagger.yaml).
-	// default: "/swagger/swagger.yaml"
+	// default: "" -> derived from Config.BasePath and Config.YAMLFileName ("/swagger/swagger.yaml")
 	URL string `json:"url,omitempty"`
 
 	// Enables overriding configuration parameters via URL search params.
@@ -257,9 +280,11 @@ type OAuthConfig struct {
 }
 
 var DefaultUIConfig = SwaggerUIConfig{
-	URL:    "/swagger/swagger.yaml",
-	Title:  "Swagger UI",
-	Layout: "StandaloneLayout",
+	Title:        "Swagger UI",
+	AssetsSource: AssetsSourceCDN,
+	Version:      "5.20.5",
+	CDNURL:       "https://unpkg.com/swagger-ui-dist@{version}",
+	Layout:       "StandaloneLayout",
 	Plugins: []template.JS{
 		template.JS("SwaggerUIBundle.plugins.DownloadUrl"),
 	},
@@ -286,14 +311,31 @@ var DefaultUIConfig = SwaggerUIConfig{
 func swaggerUIConfigDefault(ui_config SwaggerUIConfig) SwaggerUIConfig {
 	cfg := ui_config
 
-	if cfg.URL == "" {
-		cfg.URL = DefaultUIConfig.URL
-	}
-
 	if cfg.Title == "" {
 		cfg.Title = DefaultUIConfig.Title
 	}
 
+	if cfg.AssetsSource == "" {
+		cfg.AssetsSource = DefaultUIConfig.AssetsSource
+	}
+
+	if cfg.AssetsFS == nil {
+		cfg.AssetsFS = EmbeddedSwaggerUIAssets
+	}
+
+	if cfg.Version == "" {
+		cfg.Version = DefaultUIConfig.Version
+	}
+
+	if cfg.CDNURL == "" {
+		cfg.CDNURL = DefaultUIConfig.CDNURL
+	}
+
+	if cfg.AssetsURL == "" && cfg.AssetsSource == AssetsSourceCDN {
+		cfg.AssetsURL = strings.ReplaceAll(cfg.CDNURL, "{version}", cfg.Version)
+	}
+	cfg.AssetsURL = strings.TrimSuffix(cfg.AssetsURL, "/")
+
 	if cfg.Layout == "" {
 		cfg.Layout = DefaultUIConfig.Layout
 	}
@@ -328,10 +370,12 @@ const indexPageTmpl string = `
   <head>
     <meta charset="UTF-8">
     <title>{{.Title}}</title>
+    {{- if eq .AssetsSource "cdn"}}
     <link href="https://fonts.googleapis.com/css?family=Open+Sans:400,700|Source+Code+Pro:300,600|Titillium+Web:400,600,700" rel="stylesheet">
-  	<link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5.20.0/swagger-ui.css" />
-    <link rel="icon" type="image/png" href="./favicon-32x32.png" sizes="32x32" />
-    <link rel="icon" type="image/png" href="./favicon-16x16.png" sizes="16x16" />
+    {{- end}}
+  	<link rel="stylesheet" href="{{.AssetsURL}}/swagger-ui.css" />
+    <link rel="icon" type="image/png" href="{{.AssetsURL}}/favicon-32x32.png" sizes="32x32" />
+    <link rel="icon" type="image/png" href="{{.AssetsURL}}/favicon-16x16.png" sizes="16x16" />
     {{- if .CustomStyle}}
       <style>
         body { margin: 0; }
@@ -371,8 +415,8 @@ const indexPageTmpl string = `
       </defs>
     </svg>
     <div id="swagger-ui"></div>
- 	<script src="https://unpkg.com/swagger-ui-dist@5.20.0/swagger-ui-bundle.js" crossorigin></script>
- 	<script src="https://unpkg.com/swagger-ui-dist@5.20.0/swagger-ui-standalone-preset.js" crossorigin></script>
+ 	<script src="{{.AssetsURL}}/swagger-ui-bundle.js" crossorigin></script>
+ 	<script src="{{.AssetsURL}}/swagger-ui-standalone-preset.js" crossorigin></script>
     <script>
     window.onload = function() {
       config = {{.}};
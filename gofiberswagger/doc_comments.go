@@ -0,0 +1,342 @@
+package gofiberswagger
+
+import (
+	"go/ast"
+	"go/build"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Doc comments of handlers & types, used as summaries and descriptions         ///
+/// (Config.UseDocComments)                                                      ///
+/// ---------------------------------------------------------------------------- ///
+
+// index of doc comments keyed by:
+//   - functions: runtime name (`pkg/path.Func`, `pkg/path.(*Type).Method`, `pkg/path.Type.Method`)
+//   - types: `pkg/path.Type`
+//   - struct fields: `pkg/path.Type.Field`
+type docCommentsIndex struct {
+	mutex         sync.Mutex
+	comments      map[string]string
+	parsed_dirs   map[string]bool
+	resolved_pkgs map[string]bool
+}
+
+var docComments = &docCommentsIndex{
+	comments:      make(map[string]string),
+	parsed_dirs:   make(map[string]bool),
+	resolved_pkgs: make(map[string]bool),
+}
+
+// RegisterDocComments registers precomputed doc comments, used by binaries shipped without their sources.
+// The index is generated by `go run github.com/TDiblik/gofiber-swagger/gofiberswagger/cmd/docindex`
+// (see it's documentation for the `go generate` usage).
+func RegisterDocComments(comments map[string]string) {
+	docComments.mutex.Lock()
+	defer docComments.mutex.Unlock()
+
+	for key, comment := range comments {
+		docComments.comments[key] = comment
+	}
+}
+
+// CollectDocComments parses the go files inside dir (ignoring tests) and returns their doc comments,
+// keyed by pkg_path the same way as expected by RegisterDocComments.
+func CollectDocComments(dir string, pkg_path string) (map[string]string, error) {
+	return collectDocComments(dir, pkg_path, false)
+}
+
+func collectDocComments(dir string, pkg_path string, include_tests bool) (map[string]string, error) {
+	file_set := token.NewFileSet()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := map[string]string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || (!include_tests && strings.HasSuffix(name, "_test.go")) {
+			continue
+		}
+		file, err := parser.ParseFile(file_set, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		// external test packages have a different import path
+		if strings.HasSuffix(file.Name.Name, "_test") {
+			continue
+		}
+		collectFileDocComments(file, pkg_path, comments)
+	}
+	return comments, nil
+}
+
+func collectFileDocComments(file *ast.File, pkg_path string, comments map[string]string) {
+	add := func(key string, groups ...*ast.CommentGroup) {
+		for _, group := range groups {
+			if text := strings.TrimSpace(group.Text()); text != "" {
+				comments[pkg_path+"."+key] = text
+				return
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Doc == nil {
+				continue
+			}
+			if decl.Recv == nil || len(decl.Recv.List) == 0 {
+				add(decl.Name.Name, decl.Doc)
+				continue
+			}
+			receiver := decl.Recv.List[0].Type
+			pointer := false
+			if star, ok := receiver.(*ast.StarExpr); ok {
+				receiver = star.X
+				pointer = true
+			}
+			// generic receivers (`T[K]`)
+			switch generic := receiver.(type) {
+			case *ast.IndexExpr:
+				receiver = generic.X
+			case *ast.IndexListExpr:
+				receiver = generic.X
+			}
+			ident, ok := receiver.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			if pointer {
+				add("(*"+ident.Name+")."+decl.Name.Name, decl.Doc)
+			} else {
+				add(ident.Name+"."+decl.Name.Name, decl.Doc)
+			}
+
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				type_spec := spec.(*ast.TypeSpec)
+				// the doc of a single type declaration is attached to the GenDecl
+				if decl.Lparen.IsValid() {
+					add(type_spec.Name.Name, type_spec.Doc, type_spec.Comment)
+				} else {
+					add(type_spec.Name.Name, type_spec.Doc, decl.Doc, type_spec.Comment)
+				}
+
+				struct_type, ok := type_spec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				for _, field := range struct_type.Fields.List {
+					for _, name := range field.Names {
+						if field.Doc != nil || field.Comment != nil {
+							groups := []*ast.CommentGroup{}
+							if field.Doc != nil {
+								groups = append(groups, field.Doc)
+							}
+							if field.Comment != nil {
+								groups = append(groups, field.Comment)
+							}
+							add(type_spec.Name.Name+"."+name.Name, groups...)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// parses the directory (once), call only while holding docComments.mutex!
+func (index *docCommentsIndex) parseDir(dir string, pkg_path string) {
+	if dir == "" || index.parsed_dirs[dir] {
+		return
+	}
+	index.parsed_dirs[dir] = true
+
+	// handlers may be declared inside tests as well
+	comments, err := collectDocComments(dir, pkg_path, true)
+	if err != nil {
+		return
+	}
+	for key, comment := range comments {
+		// precomputed comments take precedence
+		if _, ok := index.comments[key]; !ok {
+			index.comments[key] = comment
+		}
+	}
+}
+
+// returns the doc comment of the function, resolving it's source when not found inside the index
+func (index *docCommentsIndex) function(fn any) string {
+	value := reflect.ValueOf(fn)
+	if !value.IsValid() || value.Kind() != reflect.Func || value.IsNil() {
+		return ""
+	}
+	runtime_func := runtime.FuncForPC(value.Pointer())
+	if runtime_func == nil {
+		return ""
+	}
+	name := normalizeFuncName(runtime_func.Name())
+
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	if comment, ok := index.comments[name]; ok {
+		return comment
+	}
+	pkg_path := funcPkgPath(name)
+	file, _ := runtime_func.FileLine(runtime_func.Entry())
+	if filepath.IsAbs(file) {
+		index.parseDir(filepath.Dir(file), pkg_path)
+	} else if !index.resolved_pkgs[pkg_path] {
+		// method values (`h.List`) are wrapped by `<autogenerated>` functions, their sources are found through the package
+		index.resolved_pkgs[pkg_path] = true
+		index.parseDir(findPackageDir(pkg_path), pkg_path)
+	}
+	return index.comments[name]
+}
+
+// `pkg.(*T).Method-fm` (method values) -> `pkg.(*T).Method`, `pkg.T[...].Method` (generics) -> `pkg.T.Method`
+func normalizeFuncName(name string) string {
+	name = strings.TrimSuffix(name, "-fm")
+	return strings.ReplaceAll(name, "[...]", "")
+}
+
+// returns the directory with the sources of the package ("" when unknown)
+func findPackageDir(pkg_path string) string {
+	if pkg_path != "main" {
+		working_dir, _ := os.Getwd()
+		if pkg, err := build.Import(pkg_path, working_dir, build.FindOnly); err == nil {
+			return pkg.Dir
+		}
+		return ""
+	}
+
+	// the main package can't be imported, look for it inside the stack (Register is usually called by main)
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)])
+	for {
+		frame, more := frames.Next()
+		if funcPkgPath(frame.Function) == "main" && filepath.IsAbs(frame.File) {
+			return filepath.Dir(frame.File)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// returns the doc comment of the type (or it's field, when field != ""), resolving it's source when not found inside the index
+func (index *docCommentsIndex) typ(t reflect.Type, field string) string {
+	pkg_path := t.PkgPath()
+	if pkg_path == "" || t.Name() == "" {
+		return ""
+	}
+	key := pkg_path + "." + strings.Split(t.Name(), "[")[0]
+	if field != "" {
+		key += "." + field
+	}
+
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	if comment, ok := index.comments[key]; ok {
+		return comment
+	}
+	if !index.resolved_pkgs[pkg_path] {
+		index.resolved_pkgs[pkg_path] = true
+		index.parseDir(findPackageDir(pkg_path), pkg_path)
+	}
+	return index.comments[key]
+}
+
+// `github.com/a/b.(*T).Method` -> `github.com/a/b`
+func funcPkgPath(name string) string {
+	last_slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[last_slash+1:], ".")
+	if dot == -1 {
+		return name
+	}
+	return name[:last_slash+1+dot]
+}
+
+// first sentence of the comment is used as the summary, the whole comment as the description
+func applyOperationDocComment(operation *RouteInfo, comment string) {
+	if comment == "" {
+		return
+	}
+	if operation.Summary == "" {
+		operation.Summary = new(doc.Package).Synopsis(comment)
+	}
+	if operation.Description == "" && comment != operation.Summary {
+		operation.Description = comment
+	}
+}
+
+// returns a copy of the component schema with the descriptions taken from the doc comments of t and it's fields.
+// Fields documented by the doc tag (`doc:"..."`, named docTag) are left as they are.
+// The original schema is shared by the registry and must not be modified.
+func applySchemaDocComments(schema *SchemaRef, t reflect.Type, docTag string) *SchemaRef {
+	if schema == nil || schema.Value == nil {
+		return schema
+	}
+	value := *schema.Value
+	if value.Description == "" {
+		value.Description = docComments.typ(t, "")
+	}
+	value.Properties = make(Schemas, len(schema.Value.Properties))
+	for name, property := range schema.Value.Properties {
+		value.Properties[name] = property
+	}
+	applyFieldsDocComments(&value, t, docTag)
+
+	return &SchemaRef{Ref: schema.Ref, Extensions: schema.Extensions, Origin: schema.Origin, Value: &value}
+}
+
+func applyFieldsDocComments(schema *Schema, t reflect.Type, docTag string) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		field_type := field.Type
+		for field_type.Kind() == reflect.Pointer {
+			field_type = field_type.Elem()
+		}
+		if field.Anonymous && field_type.Kind() == reflect.Struct {
+			applyFieldsDocComments(schema, field_type, docTag)
+			continue
+		}
+
+		name := field.Name
+		if json_name := strings.Split(field.Tag.Get("json"), ",")[0]; json_name != "" {
+			name = json_name
+		}
+		property := schema.Properties[name]
+		if property == nil || property.Value == nil {
+			continue
+		}
+		// descriptions set explicitly (`doc:"..."`) take precedence
+		if _, ok := field.Tag.Lookup(docTag); ok {
+			continue
+		}
+		comment := docComments.typ(t, field.Name)
+		if comment == "" || strings.HasPrefix(property.Value.Description, comment) {
+			continue
+		}
+
+		value := *property.Value
+		value.Description = strings.TrimSpace(comment + " " + value.Description)
+		schema.Properties[name] = &SchemaRef{Ref: property.Ref, Extensions: property.Extensions, Origin: property.Origin, Value: &value}
+	}
+}
@@ -0,0 +1,171 @@
+package gofiberswagger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// DocumentedUser is a user of the documented API.
+type DocumentedUser struct {
+	// Name of the user.
+	Name string `json:"name"`
+	Age  int    `json:"age,omitempty"` // Age in years.
+	Note string `json:"note"`
+}
+
+// documentedHandler creates a new user. The created user is returned back.
+func documentedHandler(c fiber.Ctx) error {
+	return c.SendStatus(200)
+}
+
+// typedDocumentedHandler updates the user.
+func typedDocumentedHandler(c fiber.Ctx, req DocumentedUser) (DocumentedUser, error) {
+	return req, nil
+}
+
+type documentedHandlers struct{}
+
+// List lists the users.
+func (h *documentedHandlers) List(c fiber.Ctx) error {
+	return c.SendStatus(200)
+}
+
+type documentedGenericHandlers[T any] struct{}
+
+// Get returns the resource.
+func (h documentedGenericHandlers[T]) Get(c fiber.Ctx) error {
+	return c.SendStatus(200)
+}
+
+func TestRegister_UseDocComments(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	router.Post("/users", &RouteInfo{
+		RequestBody: NewRequestBodyJSON[DocumentedUser](),
+	}, documentedHandler)
+	router.Put("/users", nil, Handle(typedDocumentedHandler))
+	router.Get("/explicit", &RouteInfo{Summary: "explicit summary"}, documentedHandler)
+	handlers := &documentedHandlers{}
+	router.Get("/users", nil, handlers.List)
+
+	t.Run("disabled by default", func(t *testing.T) {
+		config := &Config{}
+		assert.NoError(t, registry.register(app, config))
+		assert.Empty(t, config.Swagger.Paths.Find("/users").Post.Summary)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		config := &Config{UseDocComments: true}
+		assert.NoError(t, registry.register(app, config))
+
+		post := config.Swagger.Paths.Find("/users").Post
+		assert.Equal(t, "documentedHandler creates a new user.", post.Summary)
+		assert.Equal(t, "documentedHandler creates a new user. The created user is returned back.", post.Description)
+
+		put := config.Swagger.Paths.Find("/users").Put
+		assert.Equal(t, "typedDocumentedHandler updates the user.", put.Summary)
+		assert.Empty(t, put.Description)
+
+		// method values
+		list := config.Swagger.Paths.Find("/users").Get
+		assert.Equal(t, "List lists the users.", list.Summary)
+
+		explicit := config.Swagger.Paths.Find("/explicit").Get
+		assert.Equal(t, "explicit summary", explicit.Summary)
+
+		var user *SchemaRef
+		for name, schema := range config.Swagger.Components.Schemas {
+			if filepath.Ext(name) == "" && schema.Value != nil && schema.Value.Title == "DocumentedUser" {
+				user = schema
+			}
+		}
+		assert.NotNil(t, user)
+		assert.Equal(t, "DocumentedUser is a user of the documented API.", user.Value.Description)
+		assert.Equal(t, "Name of the user.", user.Value.Properties["name"].Value.Description)
+		assert.Contains(t, user.Value.Properties["age"].Value.Description, "Age in years.")
+		assert.Empty(t, user.Value.Properties["note"].Value.Description)
+
+		// the schemas of the registry are left untouched
+		for _, schema := range registry.getAcquiredSchemas() {
+			assert.Empty(t, schema.Value.Description)
+		}
+	})
+}
+
+func TestCollectDocComments(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	source := `package example
+
+// Handler handles things.
+func Handler() {}
+
+// Method is a method.
+func (s *Service) Method() {}
+
+// Value is a value method.
+func (s Service) Value() {}
+
+// Service is a service.
+type Service struct {
+	// Field is a field.
+	Field string
+}
+
+type (
+	// Grouped is declared inside a group.
+	Grouped int
+)
+`
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "example.go"), []byte(source), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "example_test.go"), []byte("package example\n\n// Ignored is declared in tests.\nfunc Ignored() {}\n"), 0o644))
+
+	comments, err := CollectDocComments(dir, "example.com/pkg")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"example.com/pkg.Handler":           "Handler handles things.",
+		"example.com/pkg.(*Service).Method": "Method is a method.",
+		"example.com/pkg.Service.Value":     "Value is a value method.",
+		"example.com/pkg.Service":           "Service is a service.",
+		"example.com/pkg.Service.Field":     "Field is a field.",
+		"example.com/pkg.Grouped":           "Grouped is declared inside a group.",
+	}, comments)
+}
+
+func TestDocCommentsIndex_MethodValues(t *testing.T) {
+	t.Parallel()
+
+	index := &docCommentsIndex{
+		comments:      make(map[string]string),
+		parsed_dirs:   make(map[string]bool),
+		resolved_pkgs: make(map[string]bool),
+	}
+	assert.Equal(t, "List lists the users.", index.function((&documentedHandlers{}).List))
+	assert.Equal(t, "Get returns the resource.", index.function(documentedGenericHandlers[int]{}.Get))
+	assert.Equal(t, "List lists the users.", index.function((*documentedHandlers).List))
+}
+
+func TestNormalizeFuncName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "main.(*H).List", normalizeFuncName("main.(*H).List-fm"))
+	assert.Equal(t, "main.G.Get", normalizeFuncName("main.G[...].Get-fm"))
+	assert.Equal(t, "github.com/a/b.Handler", normalizeFuncName("github.com/a/b.Handler[...]"))
+	assert.Equal(t, "github.com/a/b.Handler.func1", normalizeFuncName("github.com/a/b.Handler.func1"))
+}
+
+func TestFuncPkgPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "main", funcPkgPath("main.Handler"))
+	assert.Equal(t, "github.com/a/b", funcPkgPath("github.com/a/b.(*T).Method"))
+	assert.Equal(t, "github.com/a/b", funcPkgPath("github.com/a/b.Handler.func1"))
+}
@@ -0,0 +1,264 @@
+package gofiberswagger
+
+import (
+	"slices"
+	"strings"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Documentation shared by the routes of a group (and it's nested groups)       ///
+/// ---------------------------------------------------------------------------- ///
+
+// GroupInfo documents the routes of a group and it's nested groups. Pass it to SwaggerRouter.Group
+// alongside the group's handlers (or to SwaggerRouter.Info). It's applied when the document gets generated,
+// so it documents the routes registered before it got set (or changed) as well:
+//
+//	users := router.Group("/users", &gofiberswagger.GroupInfo{
+//		Tag:         "users",
+//		Description: "Operations about users",
+//		Security:    &gofiberswagger.SecurityRequirements{{"bearerAuth": {}}},
+//	}, authMiddleware)
+type GroupInfo struct {
+	// Name of the tag added to every route of the group (and it's nested groups, unless they declare their own),
+	// emitted inside the top level `tags`.
+	// default: "" -> the tag of the closest parent group declaring one, the prefix of the group otherwise ("/users")
+	Tag string
+	// Additional tags of every route of the group (and it's nested groups), not emitted inside the top level `tags`
+	// default: nil
+	Tags []string
+	// Description of the tag, emitted inside the top level `tags`
+	// default: ""
+	Description string
+	// External docs of the tag, emitted inside the top level `tags`
+	// default: nil
+	ExternalDocs *ExternalDocs
+
+	// Parameters of every route (e.g. headers, path parameters of the group prefix).
+	// Parameters declared by the routes (or nested groups) with the same name & location take precedence.
+	// default: nil
+	Parameters Parameters
+	// Security of the routes which don't declare their own (or inherit it from a nested group).
+	// default: nil
+	Security *SecurityRequirements
+	// Responses of every route (e.g. 401, 500). Responses declared by the routes (or nested groups)
+	// with the same status code take precedence.
+	// default: nil
+	Responses *Responses
+
+	// Template of every route of the group (and it's nested groups), deep-merged into their docs (see mergeRouteInfo).
+	// The routes and nested groups take precedence, as do Parameters, Security and Responses of this group.
+	// Summary, OperationID, Description, RequestBody and Callbacks of the template are ignored.
+	// default: nil
+	Defaults *RouteInfo
+}
+
+// documentation of a single group of the router, shared by the routes of the group,
+// so that it can be changed after they get registered (see SwaggerRouter.Info)
+type routerGroup struct {
+	parent *routerGroup
+	// full prefix of the group ("/api/users"), used as the tag when no group declares one
+	prefix string
+	info   *GroupInfo
+}
+
+func newRouterGroup(parent *routerGroup, prefix string) *routerGroup {
+	return &routerGroup{parent: parent, prefix: strings.TrimSuffix(strings.ReplaceAll(prefix, "//", "/"), "/")}
+}
+
+// returns the tag of the group's routes, call only while holding the registry's mutex!
+func (group *routerGroup) tag() string {
+	for current := group; current != nil; current = current.parent {
+		if current.info != nil && current.info.Tag != "" {
+			return current.info.Tag
+		}
+	}
+	return group.prefix
+}
+
+// returns the group docs passed between the handlers of the group (and removes them from the handlers)
+func extractGroupInfo(handlers []any) (*GroupInfo, []any) {
+	var info *GroupInfo
+	remaining := make([]any, 0, len(handlers))
+	for _, handler := range handlers {
+		switch handler := handler.(type) {
+		case *GroupInfo:
+			info = handler
+		case GroupInfo:
+			info = &handler
+		default:
+			remaining = append(remaining, handler)
+		}
+	}
+	return info, remaining
+}
+
+// applies the current docs of the groups (ordered from the outermost one) to a copy of the route info,
+// call only while holding the registry's mutex!
+func applyGroupsInfo(info *RouteInfo, groups []*routerGroup) *RouteInfo {
+	info = copyRouteInfo(info)
+	if len(groups) == 0 {
+		return info
+	}
+
+	// the innermost declarations take precedence
+	for i := len(groups) - 1; i >= 0; i-- {
+		if group := groups[i].info; group != nil {
+			mergeRouteInfo(info, group.template())
+		}
+	}
+	if tag := groups[len(groups)-1].tag(); tag != "" && !slices.Contains(info.Tags, tag) {
+		info.Tags = append(info.Tags, tag)
+	}
+
+	return info
+}
+
+// returns the defaults of the group's routes, with Tags, Parameters, Security and Responses taking precedence over Defaults
+func (group *GroupInfo) template() *RouteInfo {
+	template := &RouteInfo{Tags: group.Tags, Parameters: group.Parameters, Security: group.Security, Responses: group.Responses}
+	if group.Defaults != nil {
+		mergeRouteInfo(template, group.Defaults)
+	}
+	return template
+}
+
+// fills the info with the defaults it doesn't declare itself:
+//   - parameters (by location & name), responses (by status code) and extensions (by key) are added,
+//     the inherited parameters come first
+//   - tags are appended
+//   - security, external docs and servers are used when the info has none (an empty security is kept)
+//   - deprecated defaults are deprecated
+//
+// The maps & slices of the info are replaced (not modified), so that they can be shared.
+func mergeRouteInfo(info *RouteInfo, defaults *RouteInfo) {
+	if defaults == nil {
+		return
+	}
+
+	inherited := Parameters{}
+	for _, parameter := range defaults.Parameters {
+		if parameter == nil || parameter.Value == nil ||
+			info.Parameters.GetByInAndName(parameter.Value.In, parameter.Value.Name) != nil ||
+			inherited.GetByInAndName(parameter.Value.In, parameter.Value.Name) != nil {
+			continue
+		}
+		inherited = append(inherited, parameter)
+	}
+	if len(inherited) > 0 {
+		info.Parameters = append(inherited, info.Parameters...)
+	}
+
+	if defaults.Responses != nil && defaults.Responses.Len() > 0 {
+		responses := &Responses{}
+		if info.Responses != nil {
+			for code, response := range info.Responses.Map() {
+				responses.Set(code, response)
+			}
+			responses.Extensions = info.Responses.Extensions
+		}
+		for code, response := range defaults.Responses.Map() {
+			if responses.Value(code) == nil {
+				responses.Set(code, response)
+			}
+		}
+		info.Responses = responses
+	}
+
+	if len(defaults.Extensions) > 0 {
+		extensions := make(map[string]any, len(info.Extensions)+len(defaults.Extensions))
+		for key, value := range defaults.Extensions {
+			extensions[key] = value
+		}
+		for key, value := range info.Extensions {
+			extensions[key] = value
+		}
+		info.Extensions = extensions
+	}
+
+	for _, tag := range defaults.Tags {
+		if !slices.Contains(info.Tags, tag) {
+			info.Tags = append(slices.Clip(info.Tags), tag)
+		}
+	}
+
+	if info.Security == nil && defaults.Security != nil {
+		security := append(SecurityRequirements{}, *defaults.Security...)
+		info.Security = &security
+	}
+	if info.ExternalDocs == nil {
+		info.ExternalDocs = defaults.ExternalDocs
+	}
+	if info.Servers == nil && defaults.Servers != nil {
+		servers := append(Servers{}, *defaults.Servers...)
+		info.Servers = &servers
+	}
+	info.Deprecated = info.Deprecated || defaults.Deprecated
+}
+
+// remembers the group, so that it's tag can be emitted inside the top level `tags`
+func (r *Registry) registerGroup(group *routerGroup) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.groups = append(r.groups, group)
+}
+
+func (r *Registry) setGroupInfo(group *routerGroup, info *GroupInfo) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	group.info = info
+}
+
+// remembers the groups of the route (ordered from the outermost one), applied when the document gets generated
+func (r *Registry) setRouteGroups(method string, path string, groups []*routerGroup) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.routesGroups[getAcquiredRoutesInfoId(method, path)] = groups
+}
+
+// adds the tags of the groups used by the operations of the document, which are not declared by the user
+func (r *Registry) addGroupsTags(document *SwaggerConfig) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	used := map[string]bool{}
+	forEachOperation(document, func(method string, path string, operation *Operation) {
+		for _, tag := range operation.Tags {
+			used[tag] = true
+		}
+	})
+
+	tags := append(Tags{}, document.Tags...)
+	declared := map[string]bool{}
+	for _, tag := range document.Tags {
+		declared[tag.Name] = true
+	}
+	for _, group := range r.groups {
+		name := group.tag()
+		if name == "" || !used[name] || declared[name] {
+			continue
+		}
+		tag := &Tag{Name: name}
+		// the tag is described by the group declaring it (or by the group tagged by it's prefix)
+		if info := group.info; info != nil && (info.Tag != "" || name == group.prefix) {
+			tag.Description = info.Description
+			tag.ExternalDocs = info.ExternalDocs
+		}
+		// groups sharing the tag complete each other's docs
+		if existing := tags.Get(name); existing != nil {
+			if existing.Description == "" {
+				existing.Description = tag.Description
+			}
+			if existing.ExternalDocs == nil {
+				existing.ExternalDocs = tag.ExternalDocs
+			}
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	if len(tags) > 0 {
+		document.Tags = tags
+	}
+}
@@ -0,0 +1,266 @@
+package gofiberswagger
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwaggerRouter_GroupInfo(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	handler := func(c fiber.Ctx) error { return c.SendString("ok") }
+
+	middleware_called := false
+	api := router.Group("/api", &GroupInfo{
+		Tag:          "api",
+		Description:  "The API",
+		ExternalDocs: &ExternalDocs{URL: "https://example.com/docs"},
+		Parameters:   NewParameters(NewHeaderParameterRequired("X-Tenant-Id"), NewQueryParameter("lang")),
+		Security:     &SecurityRequirements{{"bearerAuth": {}}},
+		Responses:    NewResponses(NewResponseInfoNoContent("401", "Unauthorized"), NewResponseInfoNoContent("500", "Internal Server Error")),
+	}, func(c fiber.Ctx) error {
+		middleware_called = true
+		return c.Next()
+	})
+	v1 := api.Group("/v1")
+	users := v1.Group("/users", &GroupInfo{
+		Tag:        "users",
+		Parameters: NewParameters(NewQueryParameterExtended("lang", &Schema{Type: &Types{"string"}, Enum: []any{"en", "cs"}})),
+		Responses:  NewResponses(NewResponseInfoNoContent("500", "Users are broken")),
+	})
+	plain := router.Group("/plain/")
+	extra := api.Group("/extra", &GroupInfo{Tags: []string{"extra"}})
+
+	v1.Get("/health", nil, handler)
+	users.Get("/", &RouteInfo{
+		Tags:      []string{"custom"},
+		Responses: NewResponses(NewResponseInfoNoContent("401", "Not logged in")),
+	}, handler)
+	users.Get("/public", &RouteInfo{Security: &SecurityRequirements{}}, handler)
+	plain.Get("/x", nil, handler)
+	extra.Get("/y", nil, handler)
+
+	// the group info is not passed to fiber
+	resp, err := app.Test(httptest.NewRequest("GET", "/api/v1/health", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.True(t, middleware_called)
+
+	// used tags are emitted at the top level, the user's declarations take precedence
+	config := &Config{}
+	config.Swagger.Tags = Tags{{Name: "users", Description: "declared"}}
+	_, err = registry.generate(app, config)
+	assert.NoError(t, err)
+	paths := config.Swagger.Paths
+
+	// nested groups without docs inherit the tag of their documented parent
+	health := paths.Find("/api/v1/health").Get
+	assert.Equal(t, []string{"api"}, health.Tags)
+	assert.Len(t, health.Parameters, 2)
+	assert.Contains(t, (*health.Security)[0], "bearerAuth")
+	assert.Equal(t, 2, health.Responses.Len())
+
+	// the innermost declarations take precedence
+	list := paths.Find("/api/v1/users/").Get
+	assert.Equal(t, []string{"custom", "users"}, list.Tags)
+	assert.Len(t, list.Parameters, 2)
+	assert.Equal(t, []any{"en", "cs"}, list.Parameters.GetByInAndName("query", "lang").Schema.Value.Enum)
+	assert.Equal(t, "Not logged in", *list.Responses.Value("401").Value.Description)
+	assert.Equal(t, "Users are broken", *list.Responses.Value("500").Value.Description)
+
+	// explicitly declared security is kept
+	assert.Empty(t, *paths.Find("/api/v1/users/public").Get.Security)
+
+	// groups without any tag are tagged by their prefix, Tags are added next to the inherited tag
+	assert.Equal(t, []string{"/plain"}, paths.Find("/plain/x").Get.Tags)
+	assert.Equal(t, []string{"extra", "api"}, paths.Find("/api/extra/y").Get.Tags)
+
+	// the registered docs are left as they are
+	assert.Empty(t, registry.routesInfo[getAcquiredRoutesInfoId("GET", "/api/v1/health")].Tags)
+
+	assert.Equal(t, "declared", config.Swagger.Tags.Get("users").Description)
+	assert.Equal(t, "The API", config.Swagger.Tags.Get("api").Description)
+	assert.Equal(t, "https://example.com/docs", config.Swagger.Tags.Get("api").ExternalDocs.URL)
+	assert.NotNil(t, config.Swagger.Tags.Get("/plain"))
+	assert.Nil(t, config.Swagger.Tags.Get("/api/v1"))
+	assert.Nil(t, config.Swagger.Tags.Get("custom"))
+	assert.Nil(t, config.Swagger.Tags.Get("extra"))
+	assert.Len(t, config.Swagger.Tags, 3)
+}
+
+func TestSwaggerRouter_GroupInfoPreregistered(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	registry.RegisterRoute("GET", "/api/generated", &RouteInfo{Summary: "generated"})
+
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	api := router.Group("/api", &GroupInfo{Tag: "api"})
+	api.Get("/generated", nil, func(c fiber.Ctx) error { return nil })
+
+	config := &Config{}
+	_, err := registry.generate(app, config)
+	assert.NoError(t, err)
+	info := config.Swagger.Paths.Find("/api/generated").Get
+	assert.Equal(t, "generated", info.Summary)
+	assert.Equal(t, []string{"api"}, info.Tags)
+}
+
+func TestSwaggerRouter_GroupInfoAfterRoutes(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	handler := func(c fiber.Ctx) error { return c.SendString("ok") }
+
+	api := router.Group("/api")
+	users := api.Group("/users", &GroupInfo{})
+	api.Get("/health", nil, handler)
+	users.Get("/", nil, handler)
+
+	assert.Equal(t, []string{"/api"}, registry.getAcquiredRoutesInfo("GET", "/api/health").Tags)
+
+	// the docs of the groups are applied when the document gets generated, not when the routes get registered
+	api.Info(&GroupInfo{Tag: "api", Description: "The API", Responses: NewResponses(NewResponseInfoNoContent("500", "Internal Server Error"))})
+	users.Info(&GroupInfo{Tag: "users"})
+	router.Info(&GroupInfo{Tag: "ignored"})
+
+	config := &Config{}
+	_, err := registry.generate(app, config)
+	assert.NoError(t, err)
+	health := config.Swagger.Paths.Find("/api/health").Get
+	assert.Equal(t, []string{"api"}, health.Tags)
+	assert.NotNil(t, health.Responses.Value("500"))
+	list := config.Swagger.Paths.Find("/api/users/").Get
+	assert.Equal(t, []string{"users"}, list.Tags)
+	assert.NotNil(t, list.Responses.Value("500"))
+	assert.Equal(t, "The API", config.Swagger.Tags.Get("api").Description)
+	assert.Nil(t, config.Swagger.Tags.Get("ignored"))
+
+	// so are the changes of the docs passed to Group
+	info := &GroupInfo{Tag: "before"}
+	admin := router.Group("/admin", info)
+	admin.Get("/stats", nil, handler)
+	info.Tag = "admin"
+
+	config = &Config{}
+	_, err = registry.generate(app, config)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"admin"}, config.Swagger.Paths.Find("/admin/stats").Get.Tags)
+	assert.NotNil(t, config.Swagger.Tags.Get("admin"))
+	assert.Nil(t, config.Swagger.Tags.Get("before"))
+}
+
+type groupTestErrorEnvelope struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func TestSwaggerRouter_GroupDefaults(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	handler := func(c fiber.Ctx) error { return c.SendString("ok") }
+
+	api := router.Group("/api/v1", &GroupInfo{Defaults: &RouteInfo{
+		Tags:       []string{"v1"},
+		Parameters: NewParameters(NewHeaderParameterRequired("X-Tenant-Id")),
+		Security:   &SecurityRequirements{{"bearerAuth": {}}},
+		Responses: NewResponses(
+			NewResponseInfo[groupTestErrorEnvelope]("401", "Unauthorized"),
+			NewResponseInfo[groupTestErrorEnvelope]("403", "Forbidden"),
+			NewResponseInfo[groupTestErrorEnvelope]("500", "Internal Server Error"),
+		),
+		Extensions: map[string]any{"x-rate-limit": 100, "x-internal": false},
+		Summary:    "ignored",
+	}})
+	admin := api.Group("/admin", &GroupInfo{
+		Tag:       "admin",
+		Responses: NewResponses(NewResponseInfoNoContent("403", "Admins only")),
+		Defaults: &RouteInfo{
+			Responses:  NewResponses(NewResponseInfoNoContent("403", "overridden by GroupInfo.Responses")),
+			Extensions: map[string]any{"x-internal": true},
+			Deprecated: true,
+		},
+	})
+
+	api.Get("/items", &RouteInfo{
+		Summary:    "List items",
+		Responses:  NewResponses(NewResponseInfo[[]string]("200", "OK")),
+		Extensions: map[string]any{"x-rate-limit": 10},
+	}, handler)
+	api.Get("/status", &RouteInfo{Security: &SecurityRequirements{}}, handler)
+	admin.Delete("/items/:id", &RouteInfo{
+		Parameters: NewParameters(NewHeaderParameter("X-Tenant-Id")),
+	}, handler)
+
+	config := &Config{}
+	*config = DefaultConfig
+	config.CreateSwaggerFiles = false
+	_, err := registry.generate(app, config)
+	assert.NoError(t, err)
+	paths := config.Swagger.Paths
+
+	items := paths.Find("/api/v1/items").Get
+	assert.Equal(t, "List items", items.Summary)
+	assert.Equal(t, []string{"v1", "/api/v1"}, items.Tags)
+	assert.True(t, items.Parameters.GetByInAndName("header", "X-Tenant-Id").Required)
+	assert.Contains(t, (*items.Security)[0], "bearerAuth")
+	for _, code := range []string{"200", "401", "403", "500"} {
+		assert.NotNil(t, items.Responses.Value(code), code)
+	}
+	assert.NotNil(t, items.Responses.Value("401").Value.Content.Get("application/json"))
+	assert.Equal(t, 10, items.Extensions["x-rate-limit"])
+	assert.Equal(t, false, items.Extensions["x-internal"])
+	assert.False(t, items.Deprecated)
+
+	// an empty security makes the route public
+	status := paths.Find("/api/v1/status").Get
+	assert.Equal(t, "", status.Summary)
+	assert.Empty(t, *status.Security)
+
+	// route > inner group (GroupInfo fields > Defaults) > outer group
+	remove := paths.Find("/api/v1/admin/items/{id}").Delete
+	assert.Equal(t, []string{"v1", "admin"}, remove.Tags)
+	assert.False(t, remove.Parameters.GetByInAndName("header", "X-Tenant-Id").Required)
+	assert.NotNil(t, remove.Parameters.GetByInAndName("path", "id"))
+	assert.Equal(t, "Admins only", *remove.Responses.Value("403").Value.Description)
+	assert.Equal(t, "Unauthorized", *remove.Responses.Value("401").Value.Description)
+	assert.Equal(t, true, remove.Extensions["x-internal"])
+	assert.Equal(t, 100, remove.Extensions["x-rate-limit"])
+	assert.True(t, remove.Deprecated)
+}
+
+func TestMergeRouteInfo_DoesNotModifyDefaults(t *testing.T) {
+	t.Parallel()
+
+	defaults := &RouteInfo{
+		Tags:       []string{"a"},
+		Parameters: NewParameters(NewQueryParameter("page")),
+		Responses:  NewResponses(NewResponseInfoNoContent("500", "Internal Server Error")),
+		Extensions: map[string]any{"x-a": 1},
+	}
+	first := &RouteInfo{Tags: []string{"b"}, Extensions: map[string]any{"x-b": 2}}
+	second := &RouteInfo{}
+	mergeRouteInfo(first, defaults)
+	mergeRouteInfo(second, defaults)
+
+	assert.Equal(t, []string{"b", "a"}, first.Tags)
+	assert.Equal(t, map[string]any{"x-a": 1, "x-b": 2}, first.Extensions)
+	assert.Equal(t, []string{"a"}, second.Tags)
+	assert.Equal(t, map[string]any{"x-a": 1}, second.Extensions)
+	assert.Equal(t, []string{"a"}, defaults.Tags)
+	assert.Equal(t, map[string]any{"x-a": 1}, defaults.Extensions)
+	assert.Equal(t, 1, defaults.Responses.Len())
+	assert.Len(t, defaults.Parameters, 1)
+}
@@ -0,0 +1,203 @@
+package gofiberswagger
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Typed handlers, which derive the docs from their request & response types    ///
+/// ---------------------------------------------------------------------------- ///
+
+// TypedHandler is a handler which knows it's request & response types.
+// When passed to the SwaggerRouter, the request body, parameters and the 200 response
+// of the route get filled automatically. When used with a plain fiber router,
+// use Handler() and RouteInfo() to obtain the fiber handler and the docs.
+type TypedHandler interface {
+	// Handler returns the fiber handler, which binds the request and serializes the response
+	Handler() fiber.Handler
+	// RouteInfo returns a copy of info, with the request body, parameters and the 200 response filled
+	// (values declared in info take precedence), generated components get stored inside the DefaultRegistry
+	RouteInfo(info *RouteInfo) *RouteInfo
+
+	routeInfoIn(registry *Registry, info *RouteInfo) *RouteInfo
+	handlerFunc() any
+}
+
+type typedHandler[Req any, Resp any] struct {
+	handler func(c fiber.Ctx, req Req) (Resp, error)
+}
+
+// Handle creates a handler, which binds Req (uri / body / query / header / cookie, see c.Bind().All),
+// validates it using the app's StructValidator and serializes the returned Resp as JSON.
+//
+//	router.Post("/users/:id", nil, gofiberswagger.Handle(func(c fiber.Ctx, req UpdateUserRequest) (User, error) {
+//		...
+//	}))
+func Handle[Req any, Resp any](handler func(c fiber.Ctx, req Req) (Resp, error)) TypedHandler {
+	return typedHandler[Req, Resp]{handler: handler}
+}
+
+func (h typedHandler[Req, Resp]) Handler() fiber.Handler {
+	reqType := reflect.TypeOf((*Req)(nil)).Elem()
+	isStruct := reqType.Kind() == reflect.Struct
+	bindable := !isStruct || reqType.NumField() > 0
+	return func(c fiber.Ctx) error {
+		var req Req
+		if bindable {
+			var err error
+			if isStruct {
+				err = c.Bind().All(&req)
+			} else if len(c.Body()) > 0 {
+				err = c.Bind().Body(&req)
+			}
+			if err != nil {
+				var fiberErr *fiber.Error
+				if errors.As(err, &fiberErr) {
+					return err
+				}
+				return fiber.NewError(fiber.StatusBadRequest, err.Error())
+			}
+		}
+
+		resp, err := h.handler(c, req)
+		if err != nil {
+			return err
+		}
+		return c.JSON(resp)
+	}
+}
+
+func (h typedHandler[Req, Resp]) handlerFunc() any {
+	return h.handler
+}
+
+func (h typedHandler[Req, Resp]) RouteInfo(info *RouteInfo) *RouteInfo {
+	return h.routeInfoIn(DefaultRegistry, info)
+}
+
+func (h typedHandler[Req, Resp]) routeInfoIn(registry *Registry, info *RouteInfo) *RouteInfo {
+	registry.schemasMutex.Lock()
+	defer registry.schemasMutex.Unlock()
+
+	info = copyRouteInfo(info)
+	reqType := reflect.TypeOf((*Req)(nil)).Elem()
+	respType := reflect.TypeOf((*Resp)(nil)).Elem()
+
+	// parameters declared by the user take precedence
+	for _, parameter := range registry.generateParameters(reqType) {
+		if info.Parameters.GetByInAndName(parameter.Value.In, parameter.Value.Name) == nil {
+			info.AddParameter(parameter.Value)
+		}
+	}
+
+	if info.RequestBody == nil {
+		if schema := registry.generateRequestBodySchema(reqType); schema != nil {
+			info.RequestBody = &RequestBodyRef{Value: openapi3.NewRequestBody().WithJSONSchemaRef(schema).WithRequired(true)}
+		}
+	}
+
+	// copy the responses, so that the original info doesn't get modified
+	responses := &Responses{}
+	if info.Responses != nil {
+		responses.Extensions = info.Responses.Extensions
+		for status, response := range info.Responses.Map() {
+			responses.Set(status, response)
+		}
+	}
+	info.Responses = responses
+	if info.Responses.Value("200") == nil {
+		response := openapi3.NewResponse().WithDescription("OK").WithJSONSchemaRef(registry.generateSchema(respType, false))
+		info.Responses.Set("200", &ResponseRef{Value: response})
+	}
+
+	return info
+}
+
+// generates the schema of the body part of the request type, fields bound from uri / query / header / cookie are excluded.
+// Returns nil, when the request has no body.
+// call only while holding r.schemasMutex!
+func (r *Registry) generateRequestBodySchema(t reflect.Type) *SchemaRef {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return r.generateSchema(t, false)
+	}
+	if !hasParameterFields(t) {
+		if t.NumField() == 0 {
+			return nil
+		}
+		return r.generateSchema(t, false)
+	}
+
+	schema := &Schema{
+		Type:       &Types{"object"},
+		Properties: make(Schemas),
+		Required:   []string{},
+	}
+	r.addRequestBodyProperties(schema, t)
+	if len(schema.Properties) == 0 {
+		return nil
+	}
+	return &SchemaRef{Value: schema}
+}
+
+// call only while holding r.schemasMutex!
+func (r *Registry) addRequestBodyProperties(schema *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		if field.Anonymous {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				r.addRequestBodyProperties(schema, fieldType)
+				continue
+			}
+		}
+		if !field.IsExported() || isParameterField(field) || field.Tag.Get("json") == "-" {
+			continue
+		}
+
+		fieldName, result, required := r.generateFieldSchema(field)
+		if result == nil {
+			continue
+		}
+		if required {
+			schema.Required = append(schema.Required, fieldName)
+		}
+		schema.Properties[fieldName] = result
+	}
+}
+
+func isParameterField(field reflect.StructField) bool {
+	for _, parameterTag := range parameterTags {
+		if _, ok := field.Tag.Lookup(parameterTag.Tag); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func hasParameterFields(t reflect.Type) bool {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && fieldType.Kind() == reflect.Struct && hasParameterFields(fieldType) {
+			return true
+		}
+		if isParameterField(field) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package gofiberswagger
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type UpdateUserRequest struct {
+	ID      int    `uri:"id"`
+	DryRun  bool   `query:"dry_run"`
+	TraceID string `header:"X-Trace-Id"`
+	Name    string `json:"name" validate:"required"`
+	Email   string `json:"email"`
+}
+
+type UpdateUserResponse struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	DryRun bool   `json:"dry_run"`
+}
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	router.Put("/users/:id", &RouteInfo{
+		Responses: NewResponses(NewResponseInfo[fiber.Error]("404", "not found")),
+	}, Handle(func(c fiber.Ctx, req UpdateUserRequest) (UpdateUserResponse, error) {
+		if req.ID == 0 {
+			return UpdateUserResponse{}, fiber.ErrNotFound
+		}
+		return UpdateUserResponse{ID: req.ID, Name: req.Name, DryRun: req.DryRun}, nil
+	}))
+
+	t.Run("binds request and serializes response", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/users/42?dry_run=true", strings.NewReader(`{"name":"john"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		var response UpdateUserResponse
+		assert.NoError(t, json.Unmarshal(body, &response))
+		assert.Equal(t, UpdateUserResponse{ID: 42, Name: "john", DryRun: true}, response)
+	})
+
+	t.Run("returns handler errors", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodPut, "/users/0", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("returns bad request on invalid input", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodPut, "/users/abc", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("fills the docs", func(t *testing.T) {
+		config := &Config{}
+		assert.NoError(t, registry.register(app, config))
+
+		operation := config.Swagger.Paths.Find("/users/{id}").Put
+		assert.NotNil(t, operation)
+
+		assert.Len(t, operation.Parameters, 3)
+		id := operation.Parameters.GetByInAndName(openapi3.ParameterInPath, "id")
+		assert.NotNil(t, id)
+		assert.Equal(t, "integer", (*id.Schema.Value.Type)[0])
+		assert.NotNil(t, operation.Parameters.GetByInAndName(openapi3.ParameterInQuery, "dry_run"))
+		assert.NotNil(t, operation.Parameters.GetByInAndName(openapi3.ParameterInHeader, "X-Trace-Id"))
+
+		assert.NotNil(t, operation.RequestBody)
+		body := operation.RequestBody.Value.Content.Get("application/json").Schema.Value
+		assert.Len(t, body.Properties, 2)
+		assert.Contains(t, body.Properties, "name")
+		assert.Contains(t, body.Properties, "email")
+		assert.Equal(t, []string{"name"}, body.Required)
+
+		ok := operation.Responses.Value("200")
+		assert.NotNil(t, ok)
+		assert.Contains(t, ok.Value.Content.Get("application/json").Schema.Ref, "UpdateUserResponse")
+		// declared responses are kept
+		assert.NotNil(t, operation.Responses.Value("404"))
+	})
+}
+
+func TestHandle_RouteInfoDoesNotModifyInput(t *testing.T) {
+	t.Parallel()
+
+	info := &RouteInfo{Responses: NewResponses()}
+	handler := Handle(func(c fiber.Ctx, req struct{}) ([]string, error) {
+		return []string{"a"}, nil
+	})
+	result := handler.RouteInfo(info)
+
+	assert.Nil(t, result.RequestBody)
+	assert.Empty(t, result.Parameters)
+	assert.NotNil(t, result.Responses.Value("200"))
+	assert.Nil(t, info.Responses.Value("200"))
+}
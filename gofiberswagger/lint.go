@@ -0,0 +1,437 @@
+package gofiberswagger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Validation and linting of the generated document                             ///
+/// ---------------------------------------------------------------------------- ///
+
+// LintSeverity decides what happens with the issues found by a lint rule
+type LintSeverity int
+
+const (
+	// LintSeverityOff disables the rule
+	LintSeverityOff LintSeverity = iota
+	// LintSeverityWarning reports the issues (logs them, unless Config.OnLintIssues is set)
+	LintSeverityWarning
+	// LintSeverityError reports the issues and fails the generation (Register / GenerateHandlers return a *LintError)
+	LintSeverityError
+)
+
+func (s LintSeverity) String() string {
+	switch s {
+	case LintSeverityWarning:
+		return "warning"
+	case LintSeverityError:
+		return "error"
+	}
+	return "off"
+}
+
+// names of the built-in lint rules
+const (
+	// operations without a summary
+	LintRuleMissingSummary = "missing-summary"
+	// operations without any documented 4xx (or default) response
+	LintRuleUndocumentedClientErrors = "undocumented-4xx"
+	// operations without any documented 5xx (or default) response
+	LintRuleUndocumentedServerErrors = "undocumented-5xx"
+	// operations without tags
+	LintRuleUntaggedOperation = "untagged-operation"
+	// property & parameter names not following the naming style used by the most of them (camelCase, snake_case, ...)
+	LintRuleInconsistentNaming = "inconsistent-naming"
+)
+
+// LintRule checks the generated document. The Severity of the returned issues is filled in by the linter.
+type LintRule struct {
+	Name  string
+	Check func(document *SwaggerConfig) []LintIssue
+}
+
+// LintIssue is a problem found by a lint rule. Method & Path are empty for issues not related to an operation.
+type LintIssue struct {
+	Rule     string
+	Severity LintSeverity
+	Method   string
+	Path     string
+	Message  string
+}
+
+func (i LintIssue) String() string {
+	if i.Path == "" {
+		return fmt.Sprintf("%s [%s]: %s", i.Severity, i.Rule, i.Message)
+	}
+	return fmt.Sprintf("%s [%s] %s %s: %s", i.Severity, i.Rule, i.Method, i.Path, i.Message)
+}
+
+// LintError is returned by Register / GenerateHandlers when a rule with the LintSeverityError severity found issues
+type LintError struct {
+	Issues []LintIssue
+}
+
+func (e *LintError) Error() string {
+	messages := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		messages = append(messages, issue.String())
+	}
+	return "gofiber-swagger: the generated document didn't pass the linter -> " + strings.Join(messages, "; ")
+}
+
+// BuiltinLintRules are the rules configurable by Config.Lint without being listed in Config.LintRules
+var BuiltinLintRules = []LintRule{
+	{Name: LintRuleMissingSummary, Check: lintMissingSummary},
+	{Name: LintRuleUndocumentedClientErrors, Check: func(document *SwaggerConfig) []LintIssue {
+		return lintUndocumentedResponses(document, "4")
+	}},
+	{Name: LintRuleUndocumentedServerErrors, Check: func(document *SwaggerConfig) []LintIssue {
+		return lintUndocumentedResponses(document, "5")
+	}},
+	{Name: LintRuleUntaggedOperation, Check: lintUntaggedOperation},
+	{Name: LintRuleInconsistentNaming, Check: lintInconsistentNaming},
+}
+
+// LintDocument checks the document using the built-in and the provided rules, enabled by the severities
+// (keyed by the rule names, unlisted rules are off). Returns the issues ordered by the rules.
+func LintDocument(document *SwaggerConfig, severities map[string]LintSeverity, rules ...LintRule) []LintIssue {
+	issues := []LintIssue{}
+	for _, rule := range append(slices.Clone(BuiltinLintRules), rules...) {
+		severity := severities[rule.Name]
+		if severity == LintSeverityOff || rule.Check == nil {
+			continue
+		}
+		for _, issue := range rule.Check(document) {
+			issue.Rule = rule.Name
+			issue.Severity = severity
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// validates the document against the OpenAPI specification and checks that all local $refs can be resolved
+func validateDocument(document *SwaggerConfig) error {
+	if err := document.Validate(context.Background()); err != nil {
+		return err
+	}
+	if dangling := findDanglingRefs(document); len(dangling) > 0 {
+		return errors.New("unresolvable $refs: " + strings.Join(dangling, ", "))
+	}
+	return nil
+}
+
+// openapi requires at least one response, operations without any get an empty default one
+func addDefaultResponses(document *SwaggerConfig) {
+	forEachOperation(document, func(method string, path string, operation *Operation) {
+		if operation.Responses == nil || operation.Responses.Len() == 0 {
+			operation.Responses = NewResponsesRaw(map[string]*ResponseRef{
+				"default": {Value: openapi3.NewResponse().WithDescription("")},
+			})
+		}
+	})
+}
+
+// lints the document as configured, reports the issues and returns a *LintError for the LintSeverityError issues
+func lintDocument(config *Config) ([]LintIssue, error) {
+	if len(config.Lint) == 0 {
+		return nil, nil
+	}
+	issues := LintDocument(&config.Swagger, config.Lint, config.LintRules...)
+
+	if config.OnLintIssues != nil {
+		config.OnLintIssues(issues)
+	} else {
+		for _, issue := range issues {
+			if issue.Severity == LintSeverityWarning {
+				log.Println("gofiber-swagger:", issue)
+			}
+		}
+	}
+
+	failed := []LintIssue{}
+	for _, issue := range issues {
+		if issue.Severity == LintSeverityError {
+			failed = append(failed, issue)
+		}
+	}
+	if len(failed) > 0 {
+		return issues, &LintError{Issues: failed}
+	}
+	return issues, nil
+}
+
+// calls the function for every operation of the document (ordered by path & method)
+func forEachOperation(document *SwaggerConfig, fn func(method string, path string, operation *Operation)) {
+	if document.Paths == nil {
+		return
+	}
+	paths := document.Paths.Map()
+	for _, path := range sortedKeys(paths) {
+		if paths[path] == nil {
+			continue
+		}
+		operations := paths[path].Operations()
+		for _, method := range sortedKeys(operations) {
+			fn(method, path, operations[method])
+		}
+	}
+}
+
+func lintMissingSummary(document *SwaggerConfig) []LintIssue {
+	issues := []LintIssue{}
+	forEachOperation(document, func(method string, path string, operation *Operation) {
+		if strings.TrimSpace(operation.Summary) == "" {
+			issues = append(issues, LintIssue{Method: method, Path: path, Message: "the operation has no summary"})
+		}
+	})
+	return issues
+}
+
+// reports operations without a response of the class (`4` -> 4xx), or a default response
+func lintUndocumentedResponses(document *SwaggerConfig, class string) []LintIssue {
+	issues := []LintIssue{}
+	forEachOperation(document, func(method string, path string, operation *Operation) {
+		if operation.Responses != nil {
+			for code := range operation.Responses.Map() {
+				if code == "default" || strings.HasPrefix(code, class) {
+					return
+				}
+			}
+		}
+		issues = append(issues, LintIssue{Method: method, Path: path, Message: "the operation documents no " + class + "xx response"})
+	})
+	return issues
+}
+
+func lintUntaggedOperation(document *SwaggerConfig) []LintIssue {
+	issues := []LintIssue{}
+	forEachOperation(document, func(method string, path string, operation *Operation) {
+		if len(operation.Tags) == 0 {
+			issues = append(issues, LintIssue{Method: method, Path: path, Message: "the operation has no tags"})
+		}
+	})
+	return issues
+}
+
+// naming styles recognized by LintRuleInconsistentNaming
+const (
+	namingStyleNone   = ""
+	namingStyleCamel  = "camelCase"
+	namingStylePascal = "PascalCase"
+	namingStyleSnake  = "snake_case"
+	namingStyleKebab  = "kebab-case"
+	namingStyleMixed  = "mixed"
+)
+
+// returns the naming style of the name, namingStyleNone for names which match multiple styles (`id`)
+func getNamingStyle(name string) string {
+	has_upper := strings.ContainsFunc(name, unicode.IsUpper)
+	has_underscore := strings.Contains(name, "_")
+	has_dash := strings.Contains(name, "-")
+	switch {
+	case has_underscore && has_dash, (has_underscore || has_dash) && has_upper:
+		return namingStyleMixed
+	case has_underscore:
+		return namingStyleSnake
+	case has_dash:
+		return namingStyleKebab
+	case name != "" && unicode.IsUpper([]rune(name)[0]):
+		return namingStylePascal
+	case has_upper:
+		return namingStyleCamel
+	}
+	return namingStyleNone
+}
+
+// reports the property names of the component schemas and the query parameter names, which don't follow
+// the naming style used by the most of them
+func lintInconsistentNaming(document *SwaggerConfig) []LintIssue {
+	type namedItem struct {
+		name    string
+		style   string
+		issue   LintIssue
+		subject string
+	}
+	items := []namedItem{}
+
+	if document.Components != nil {
+		for _, schema_name := range sortedKeys(document.Components.Schemas) {
+			schema := document.Components.Schemas[schema_name]
+			if schema == nil || schema.Value == nil {
+				continue
+			}
+			for _, property := range sortedKeys(schema.Value.Properties) {
+				items = append(items, namedItem{name: property, subject: fmt.Sprintf("the property %q of the schema %q", property, schema_name)})
+			}
+		}
+	}
+	forEachOperation(document, func(method string, path string, operation *Operation) {
+		for _, parameter := range operation.Parameters {
+			if parameter == nil || parameter.Value == nil || parameter.Value.In != openapi3.ParameterInQuery {
+				continue
+			}
+			items = append(items, namedItem{
+				name:    parameter.Value.Name,
+				subject: fmt.Sprintf("the query parameter %q", parameter.Value.Name),
+				issue:   LintIssue{Method: method, Path: path},
+			})
+		}
+	})
+
+	counts := map[string]int{}
+	for i := range items {
+		items[i].style = getNamingStyle(items[i].name)
+		if items[i].style != namingStyleNone && items[i].style != namingStyleMixed {
+			counts[items[i].style]++
+		}
+	}
+	dominant := namingStyleNone
+	for _, style := range []string{namingStyleCamel, namingStyleSnake, namingStylePascal, namingStyleKebab} {
+		if counts[style] > counts[dominant] {
+			dominant = style
+		}
+	}
+	if dominant == namingStyleNone {
+		return nil
+	}
+
+	issues := []LintIssue{}
+	for _, item := range items {
+		if item.style == namingStyleNone || item.style == dominant {
+			continue
+		}
+		issue := item.issue
+		issue.Message = fmt.Sprintf("%s is %s, while the most of the names are %s", item.subject, item.style, dominant)
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// returns the local $refs (`#/components/...`) of the document, which point to non-existing components
+func findDanglingRefs(document *SwaggerConfig) []string {
+	components := document.Components
+	if components == nil {
+		components = &Components{}
+	}
+	dangling := []string{}
+	check := func(ref string) {
+		if ref == "" || slices.Contains(dangling, ref) {
+			return
+		}
+		kind, name, ok := strings.Cut(strings.TrimPrefix(ref, "#/components/"), "/")
+		if !ok || !strings.HasPrefix(ref, "#/components/") {
+			return
+		}
+		exists := false
+		switch kind {
+		case "schemas":
+			_, exists = components.Schemas[name]
+		case "parameters":
+			_, exists = components.Parameters[name]
+		case "requestBodies":
+			_, exists = components.RequestBodies[name]
+		case "responses":
+			_, exists = components.Responses[name]
+		case "headers":
+			_, exists = components.Headers[name]
+		case "securitySchemes":
+			_, exists = components.SecuritySchemes[name]
+		default:
+			exists = true
+		}
+		if !exists {
+			dangling = append(dangling, ref)
+		}
+	}
+
+	visited := map[*Schema]bool{}
+	var check_schema func(schema *SchemaRef)
+	check_schema = func(schema *SchemaRef) {
+		if schema == nil {
+			return
+		}
+		check(schema.Ref)
+		value := schema.Value
+		if value == nil || visited[value] {
+			return
+		}
+		visited[value] = true
+		for _, property := range value.Properties {
+			check_schema(property)
+		}
+		check_schema(value.Items)
+		check_schema(value.AdditionalProperties.Schema)
+		check_schema(value.Not)
+		for _, sub_schemas := range []SchemaRefs{value.AllOf, value.AnyOf, value.OneOf} {
+			for _, sub_schema := range sub_schemas {
+				check_schema(sub_schema)
+			}
+		}
+	}
+	check_content := func(content Content) {
+		for _, media_type := range content {
+			if media_type != nil {
+				check_schema(media_type.Schema)
+			}
+		}
+	}
+	check_parameter := func(parameter *ParameterRef) {
+		if parameter == nil {
+			return
+		}
+		check(parameter.Ref)
+		if parameter.Value != nil {
+			check_schema(parameter.Value.Schema)
+			check_content(parameter.Value.Content)
+		}
+	}
+	check_response := func(response *ResponseRef) {
+		if response == nil {
+			return
+		}
+		check(response.Ref)
+		if response.Value != nil {
+			check_content(response.Value.Content)
+			for _, header := range response.Value.Headers {
+				if header != nil {
+					check(header.Ref)
+					if header.Value != nil {
+						check_schema(header.Value.Schema)
+					}
+				}
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(components.Schemas) {
+		check_schema(components.Schemas[name])
+	}
+	forEachOperation(document, func(method string, path string, operation *Operation) {
+		for _, parameter := range operation.Parameters {
+			check_parameter(parameter)
+		}
+		if operation.RequestBody != nil {
+			check(operation.RequestBody.Ref)
+			if operation.RequestBody.Value != nil {
+				check_content(operation.RequestBody.Value.Content)
+			}
+		}
+		if operation.Responses != nil {
+			responses := operation.Responses.Map()
+			for _, code := range sortedKeys(responses) {
+				check_response(responses[code])
+			}
+		}
+	})
+
+	return dangling
+}
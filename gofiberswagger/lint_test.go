@@ -0,0 +1,183 @@
+package gofiberswagger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type LintUser struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	BirthDate string `json:"birth_date"`
+	ID        int    `json:"id"`
+}
+
+func newLintApp(registry *Registry) *fiber.App {
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	handler := func(c fiber.Ctx) error { return c.SendStatus(200) }
+	router.Get("/users", &RouteInfo{
+		Summary:   "List users",
+		Tags:      []string{"users"},
+		Responses: NewResponses(NewResponseInfo[[]LintUser]("200", "OK"), NewResponseInfoNoContent("400", "Bad Request")),
+	}, handler)
+	router.Get("/users/:id", &RouteInfo{
+		Responses: NewResponses(NewResponseInfo[LintUser]("200", "OK")),
+	}, handler)
+	return app
+}
+
+func TestGetNamingStyle(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, namingStyleNone, getNamingStyle("id"))
+	assert.Equal(t, namingStyleCamel, getNamingStyle("firstName"))
+	assert.Equal(t, namingStylePascal, getNamingStyle("FirstName"))
+	assert.Equal(t, namingStyleSnake, getNamingStyle("first_name"))
+	assert.Equal(t, namingStyleKebab, getNamingStyle("first-name"))
+	assert.Equal(t, namingStyleMixed, getNamingStyle("first_Name"))
+}
+
+func TestLintDocument(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	config := &Config{Lint: map[string]LintSeverity{
+		LintRuleMissingSummary:           LintSeverityWarning,
+		LintRuleUndocumentedClientErrors: LintSeverityWarning,
+		LintRuleUndocumentedServerErrors: LintSeverityOff,
+		LintRuleUntaggedOperation:        LintSeverityWarning,
+		LintRuleInconsistentNaming:       LintSeverityWarning,
+		"no-get":                         LintSeverityWarning,
+	}, LintRules: []LintRule{{
+		Name: "no-get",
+		Check: func(document *SwaggerConfig) []LintIssue {
+			return []LintIssue{{Message: "custom"}}
+		},
+	}}}
+	reported := []LintIssue{}
+	config.OnLintIssues = func(issues []LintIssue) {
+		reported = issues
+	}
+
+	handlers, err := registry.generate(newLintApp(registry), config)
+	assert.NoError(t, err)
+	assert.Equal(t, reported, handlers.LintIssues)
+
+	rules := []string{}
+	for _, issue := range handlers.LintIssues {
+		rules = append(rules, issue.Rule)
+		assert.Equal(t, LintSeverityWarning, issue.Severity)
+	}
+	assert.Equal(t, []string{LintRuleMissingSummary, LintRuleUndocumentedClientErrors, LintRuleUntaggedOperation, LintRuleInconsistentNaming, "no-get"}, rules)
+	assert.Equal(t, "/users/{id}", handlers.LintIssues[0].Path)
+	assert.Equal(t, "GET", handlers.LintIssues[0].Method)
+	assert.Contains(t, handlers.LintIssues[3].Message, `"birth_date"`)
+	assert.Contains(t, handlers.LintIssues[3].Message, "camelCase")
+}
+
+func TestLintDocument_Error(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	_, err := registry.generate(newLintApp(registry), &Config{Lint: map[string]LintSeverity{
+		LintRuleMissingSummary:    LintSeverityError,
+		LintRuleUntaggedOperation: LintSeverityWarning,
+	}, OnLintIssues: func(issues []LintIssue) {}})
+
+	var lint_error *LintError
+	assert.True(t, errors.As(err, &lint_error))
+	assert.Len(t, lint_error.Issues, 1)
+	assert.Equal(t, LintRuleMissingSummary, lint_error.Issues[0].Rule)
+	assert.Contains(t, err.Error(), "error [missing-summary] GET /users/{id}")
+}
+
+func TestLintDocument_UndocumentedOperation(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	app.Get("/plain", func(c fiber.Ctx) error { return c.SendStatus(200) })
+
+	// the placeholder response added for the validation doesn't document the operation
+	_, err := registry.generate(app, &Config{ValidateSpec: true, Lint: map[string]LintSeverity{
+		LintRuleUndocumentedClientErrors: LintSeverityError,
+		LintRuleUndocumentedServerErrors: LintSeverityError,
+	}, OnLintIssues: func(issues []LintIssue) {}})
+
+	var lint_error *LintError
+	assert.True(t, errors.As(err, &lint_error))
+	assert.Len(t, lint_error.Issues, 2)
+	for _, issue := range lint_error.Issues {
+		assert.Equal(t, "/plain", issue.Path)
+	}
+}
+
+func TestRegister_ValidateSpec(t *testing.T) {
+	t.Parallel()
+
+	handler := func(c fiber.Ctx) error { return c.SendStatus(200) }
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+		registry := NewRegistry(nil)
+		_, err := registry.generate(newLintApp(registry), &Config{ValidateSpec: true})
+		assert.NoError(t, err)
+	})
+
+	t.Run("undocumented routes", func(t *testing.T) {
+		t.Parallel()
+		registry := NewRegistry(nil)
+		app := fiber.New()
+		app.Get("/plain", handler)
+		registry.NewRouter(app).Get("/documented", &RouteInfo{Summary: "documented"}, handler)
+
+		config := &Config{ValidateSpec: true}
+		_, err := registry.generate(app, config)
+		assert.NoError(t, err)
+		for _, path := range []string{"/plain", "/documented"} {
+			responses := config.Swagger.Paths.Find(path).Get.Responses
+			assert.Equal(t, 1, responses.Len(), path)
+			assert.NotNil(t, responses.Default(), path)
+		}
+
+		// the placeholder is added only to validated documents
+		config = &Config{}
+		_, err = registry.generate(app, config)
+		assert.NoError(t, err)
+		assert.Nil(t, config.Swagger.Paths.Find("/plain").Get.Responses)
+	})
+
+	t.Run("duplicate operationIds", func(t *testing.T) {
+		t.Parallel()
+		registry := NewRegistry(nil)
+		app := fiber.New()
+		router := registry.NewRouter(app)
+		router.Get("/a", &RouteInfo{OperationID: "same", Responses: NewResponses(NewResponseInfoNoContent("204", "No Content"))}, handler)
+		router.Get("/b", &RouteInfo{OperationID: "same", Responses: NewResponses(NewResponseInfoNoContent("204", "No Content"))}, handler)
+
+		_, err := registry.generate(app, &Config{ValidateSpec: true})
+		assert.ErrorContains(t, err, "same")
+
+		// the validation is opt-in
+		_, err = registry.generate(app, &Config{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("dangling $ref", func(t *testing.T) {
+		t.Parallel()
+		registry := NewRegistry(nil)
+		app := fiber.New()
+		router := registry.NewRouter(app)
+		router.Get("/a", &RouteInfo{Responses: NewResponsesRaw(map[string]*ResponseRef{
+			"200": {Value: openapi3.NewResponse().WithDescription("OK").WithJSONSchemaRef(&SchemaRef{Ref: "#/components/schemas/Missing"})},
+		})}, handler)
+
+		_, err := registry.generate(app, &Config{ValidateSpec: true})
+		assert.ErrorContains(t, err, "#/components/schemas/Missing")
+	})
+}
@@ -0,0 +1,329 @@
+package gofiberswagger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// OpenAPI 3.0 / 3.1 output                                                     ///
+/// The document is generated (and used for the request / response validation)   ///
+/// using the 3.0 constructs modeled by kin-openapi. Documents declaring 3.1 get  ///
+/// converted into JSON Schema 2020-12 constructs while being serialized.         ///
+/// ---------------------------------------------------------------------------- ///
+
+// dialect of the schemas of the converted 3.1 documents
+const openAPI31SchemaDialect = "https://spec.openapis.org/oas/3.1/dialect/base"
+
+// order of the top level fields of the serialized documents (as listed by the specification),
+// the fields which are not listed (extensions) follow in their original order
+var documentFieldsOrder = []string{
+	"openapi", "info", "jsonSchemaDialect", "servers", "paths", "webhooks", "components", "security", "tags", "externalDocs",
+}
+
+// reports whether the version (`openapi: ...`) declares OpenAPI 3.1
+func isOpenAPI31(version string) bool {
+	return version == "3.1" || strings.HasPrefix(version, "3.1.")
+}
+
+// json object keeping the order of it's keys, so that the serialized documents keep the order of the fields
+// (kin-openapi serializes the structures as maps, so the input order is alphabetical)
+type jsonObject struct {
+	keys   []string
+	values map[string]any
+}
+
+func newJSONObject() *jsonObject {
+	return &jsonObject{values: map[string]any{}}
+}
+
+func (object *jsonObject) get(key string) (any, bool) {
+	value, ok := object.values[key]
+	return value, ok
+}
+
+// sets the value, new keys are appended
+func (object *jsonObject) set(key string, value any) {
+	if _, ok := object.values[key]; !ok {
+		object.keys = append(object.keys, key)
+	}
+	object.values[key] = value
+}
+
+// replaces the key (and it's value) by a new one at the same position, the new key is appended when the key is missing
+func (object *jsonObject) replace(key string, new_key string, value any) {
+	index := slices.Index(object.keys, key)
+	if index < 0 {
+		object.set(new_key, value)
+		return
+	}
+	object.delete(new_key)
+	index = slices.Index(object.keys, key)
+	object.keys[index] = new_key
+	delete(object.values, key)
+	object.values[new_key] = value
+}
+
+// sets the value as the first key
+func (object *jsonObject) prepend(key string, value any) {
+	object.delete(key)
+	object.keys = append([]string{key}, object.keys...)
+	object.values[key] = value
+}
+
+func (object *jsonObject) delete(key string) {
+	if _, ok := object.values[key]; !ok {
+		return
+	}
+	object.keys = slices.DeleteFunc(object.keys, func(existing string) bool { return existing == key })
+	delete(object.values, key)
+}
+
+// moves the keys listed by the order in front of the other ones
+func (object *jsonObject) sortKeys(order []string) {
+	keys := make([]string, 0, len(object.keys))
+	for _, key := range order {
+		if _, ok := object.values[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range object.keys {
+		if !slices.Contains(order, key) {
+			keys = append(keys, key)
+		}
+	}
+	object.keys = keys
+}
+
+func (object *jsonObject) MarshalJSON() ([]byte, error) {
+	buffer := bytes.Buffer{}
+	buffer.WriteByte('{')
+	for i, key := range object.keys {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		key_as_json, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value_as_json, err := json.Marshal(object.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(key_as_json)
+		buffer.WriteByte(':')
+		buffer.Write(value_as_json)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+func (object *jsonObject) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, key := range object.keys {
+		key_node, value_node := &yaml.Node{}, &yaml.Node{}
+		if err := key_node.Encode(key); err != nil {
+			return nil, err
+		}
+		if err := value_node.Encode(object.values[key]); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, key_node, value_node)
+	}
+	return node, nil
+}
+
+// decodes the JSON document into *jsonObject, []any and scalar values. Numbers get decoded as int64 / float64,
+// so that they get serialized as numbers in yaml as well.
+func decodeJSONDocument(schema_as_json []byte) (*jsonObject, error) {
+	decoder := json.NewDecoder(bytes.NewReader(schema_as_json))
+	decoder.UseNumber()
+	value, err := decodeJSONValue(decoder)
+	if err != nil {
+		return nil, err
+	}
+	document, ok := value.(*jsonObject)
+	if !ok {
+		return nil, errors.New("the document is not a json object")
+	}
+	return document, nil
+}
+
+func decodeJSONValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token := token.(type) {
+	case json.Delim:
+		switch token {
+		case '{':
+			object := newJSONObject()
+			for decoder.More() {
+				key, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeJSONValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				object.set(key.(string), value)
+			}
+			_, err = decoder.Token()
+			return object, err
+		case '[':
+			array := []any{}
+			for decoder.More() {
+				value, err := decodeJSONValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, value)
+			}
+			_, err = decoder.Token()
+			return array, err
+		}
+	case json.Number:
+		if integer, err := token.Int64(); err == nil {
+			return integer, nil
+		}
+		if float, err := token.Float64(); err == nil {
+			return float, nil
+		}
+	}
+	return token, nil
+}
+
+// converts the document generated using the 3.0 constructs into OpenAPI 3.1
+func convertDocumentTo31(document *jsonObject) {
+	if _, ok := document.get("jsonSchemaDialect"); !ok {
+		document.set("jsonSchemaDialect", openAPI31SchemaDialect)
+	}
+	dialect, _ := document.get("jsonSchemaDialect")
+	convertSchemasTo31(document, dialect)
+}
+
+// walks the parts of the document which are not schemas (paths, operations, components, ...)
+// and converts the schemas found inside of them, declaring the dialect by the root schemas
+func convertSchemasTo31(node any, dialect any) {
+	switch node := node.(type) {
+	case *jsonObject:
+		for _, key := range node.keys {
+			value := node.values[key]
+			switch {
+			case strings.HasPrefix(key, "x-") || key == "example" || key == "examples":
+				// user data, not a part of the document structure
+			case key == "schema":
+				convertRootSchemaTo31(value, dialect)
+			case key == "schemas":
+				if schemas, ok := value.(*jsonObject); ok {
+					for _, schema := range schemas.values {
+						convertRootSchemaTo31(schema, dialect)
+					}
+				}
+			default:
+				convertSchemasTo31(value, dialect)
+			}
+		}
+	case []any:
+		for _, value := range node {
+			convertSchemasTo31(value, dialect)
+		}
+	}
+}
+
+// converts the schema which is not a part of another schema, references are left as they are
+func convertRootSchemaTo31(node any, dialect any) {
+	schema, ok := node.(*jsonObject)
+	if !ok {
+		return
+	}
+	if _, ok := schema.get("$ref"); ok && len(schema.keys) == 1 {
+		return
+	}
+
+	convertSchemaTo31(schema)
+	if _, ok := schema.get("$schema"); !ok {
+		schema.prepend("$schema", dialect)
+	}
+}
+
+// converts the 3.0 constructs of the schema (and it's sub-schemas) into their 3.1 equivalents:
+// nullable -> type: [X, "null"], boolean exclusive bounds -> numeric ones, example -> examples
+// and enum options (oneOf entries with a default) -> const
+func convertSchemaTo31(node any) {
+	schema, ok := node.(*jsonObject)
+	if !ok {
+		return
+	}
+
+	for _, key := range []string{"properties", "patternProperties"} {
+		if properties, ok := schema.values[key].(*jsonObject); ok {
+			for _, property := range properties.values {
+				convertSchemaTo31(property)
+			}
+		}
+	}
+	for _, key := range []string{"items", "additionalProperties", "not"} {
+		convertSchemaTo31(schema.values[key])
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf", "prefixItems"} {
+		if sub_schemas, ok := schema.values[key].([]any); ok {
+			for _, sub_schema := range sub_schemas {
+				convertSchemaTo31(sub_schema)
+			}
+		}
+	}
+
+	if nullable, _ := schema.values["nullable"].(bool); nullable {
+		switch schema_type := schema.values["type"].(type) {
+		case string:
+			schema.set("type", []any{schema_type, "null"})
+		case []any:
+			if !slices.Contains(schema_type, any("null")) {
+				schema.set("type", append(schema_type, "null"))
+			}
+		}
+		if enum, ok := schema.values["enum"].([]any); ok && !slices.Contains(enum, nil) {
+			schema.set("enum", append(enum, nil))
+		}
+	}
+	schema.delete("nullable")
+
+	for _, bounds := range [][2]string{{"exclusiveMinimum", "minimum"}, {"exclusiveMaximum", "maximum"}} {
+		exclusive, bound := bounds[0], bounds[1]
+		if is_exclusive, ok := schema.values[exclusive].(bool); ok {
+			schema.delete(exclusive)
+			if value, ok := schema.get(bound); ok && is_exclusive {
+				schema.replace(bound, exclusive, value)
+			}
+		}
+	}
+
+	if example, ok := schema.get("example"); ok {
+		if _, ok := schema.get("examples"); !ok {
+			schema.replace("example", "examples", []any{example})
+		} else {
+			schema.delete("example")
+		}
+	}
+
+	if _, ok := schema.get("enum"); ok {
+		if options, ok := schema.values["oneOf"].([]any); ok {
+			for _, option := range options {
+				if option, ok := option.(*jsonObject); ok {
+					if value, ok := option.get("default"); ok {
+						option.replace("default", "const", value)
+					}
+				}
+			}
+		}
+	}
+}
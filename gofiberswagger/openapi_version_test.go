@@ -0,0 +1,201 @@
+package gofiberswagger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type VersionedUser struct {
+	Name   *string   `json:"name" example:"John"`
+	Age    int       `json:"age" validate:"gt=0,lt=150"`
+	Status *TestEnum `json:"status"`
+}
+
+func newVersionedDocument(version string) openapi3.T {
+	registry := NewRegistry(nil)
+	schema := CreateSchemaIn[VersionedUser](registry)
+
+	paths := &Paths{}
+	paths.Set("/users", &openapi3.PathItem{
+		Get: &RouteInfo{
+			Responses: openapi3.NewResponses(openapi3.WithStatus(200, &ResponseRef{Value: openapi3.NewResponse().WithDescription("OK").WithJSONSchemaRef(schema)})),
+		},
+	})
+	return openapi3.T{
+		OpenAPI:    version,
+		Info:       &Info{Title: "Test", Version: "1.0.0"},
+		Paths:      paths,
+		Components: &Components{Schemas: registry.getAcquiredSchemas()},
+	}
+}
+
+// compiles the JSON Schema of OpenAPI 3.1 documents validating their schemas by the OpenAPI dialect
+// (the equivalent of https://spec.openapis.org/oas/3.1/schema-base)
+func newOpenAPI31Validator(t *testing.T) *jsonschema.Schema {
+	compiler := jsonschema.NewCompiler()
+	for _, file_name := range []string{"schema.json", "dialect.json", "meta.json"} {
+		file, err := os.ReadFile(filepath.Join("testdata", "openapi31", file_name))
+		assert.NoError(t, err)
+		document, err := jsonschema.UnmarshalJSON(bytes.NewReader(file))
+		assert.NoError(t, err)
+		assert.NoError(t, compiler.AddResource(document.(map[string]any)["$id"].(string), document))
+	}
+	assert.NoError(t, compiler.AddResource("https://example.com/schema-base", map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$ref":    "https://spec.openapis.org/oas/3.1/schema/2022-10-07",
+		"$defs": map[string]any{
+			"schema": map[string]any{
+				"$dynamicAnchor": "meta",
+				"$ref":           "https://spec.openapis.org/oas/3.1/dialect/2024-11-10",
+			},
+		},
+	}))
+
+	validator, err := compiler.Compile("https://example.com/schema-base")
+	assert.NoError(t, err)
+	return validator
+}
+
+func TestIsOpenAPI31(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isOpenAPI31("3.1.0"))
+	assert.True(t, isOpenAPI31("3.1.1"))
+	assert.True(t, isOpenAPI31("3.1"))
+	assert.False(t, isOpenAPI31("3.0.3"))
+	assert.False(t, isOpenAPI31("3.10.0"))
+}
+
+func TestGenerateOpenApiSchema_30(t *testing.T) {
+	t.Parallel()
+
+	schema_as_json, _, err := generateOpenApiSchema(newVersionedDocument("3.0.3"))
+	assert.NoError(t, err)
+
+	// 3.0 documents keep the 3.0 constructs and pass the validation
+	loaded, err := openapi3.NewLoader().LoadFromData(schema_as_json)
+	assert.NoError(t, err)
+	assert.NoError(t, loaded.Validate(context.Background()))
+
+	for _, schema := range loaded.Components.Schemas {
+		properties := schema.Value.Properties
+		assert.True(t, properties["name"].Value.Nullable)
+		assert.Equal(t, &Types{"string"}, properties["name"].Value.Type)
+		assert.True(t, properties["age"].Value.ExclusiveMin)
+		assert.Equal(t, "John", properties["name"].Value.Example)
+	}
+	assert.NotContains(t, string(schema_as_json), "jsonSchemaDialect")
+	assert.True(t, strings.HasPrefix(string(schema_as_json), `{"openapi":"3.0.3","info":`))
+}
+
+func TestGenerateOpenApiSchema_31(t *testing.T) {
+	t.Parallel()
+
+	schema_as_json, schema_as_yaml, err := generateOpenApiSchema(newVersionedDocument("3.1.0"))
+	assert.NoError(t, err)
+
+	// the fields keep the order of the specification
+	assert.True(t, strings.HasPrefix(string(schema_as_json), `{"openapi":"3.1.0","info":`))
+	assert.True(t, strings.HasPrefix(string(schema_as_yaml), "openapi: 3.1.0\ninfo:"))
+
+	// the document (including it's schemas) is valid OpenAPI 3.1
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(schema_as_json))
+	assert.NoError(t, err)
+	assert.NoError(t, newOpenAPI31Validator(t).Validate(instance))
+
+	var document map[string]any
+	assert.NoError(t, json.Unmarshal(schema_as_json, &document))
+	assert.Equal(t, "3.1.0", document["openapi"])
+	assert.Equal(t, openAPI31SchemaDialect, document["jsonSchemaDialect"])
+
+	schemas := document["components"].(map[string]any)["schemas"].(map[string]any)
+	assert.Len(t, schemas, 1)
+	for _, schema := range schemas {
+		assert.Equal(t, openAPI31SchemaDialect, schema.(map[string]any)["$schema"])
+		properties := schema.(map[string]any)["properties"].(map[string]any)
+		assert.NotContains(t, properties["name"], "$schema")
+
+		name := properties["name"].(map[string]any)
+		assert.Equal(t, []any{"string", "null"}, name["type"])
+		assert.NotContains(t, name, "nullable")
+		assert.NotContains(t, name, "example")
+		assert.Equal(t, []any{"John"}, name["examples"])
+
+		age := properties["age"].(map[string]any)
+		assert.Equal(t, float64(0), age["exclusiveMinimum"])
+		assert.Equal(t, float64(150), age["exclusiveMaximum"])
+		assert.NotContains(t, age, "minimum")
+		assert.NotContains(t, age, "maximum")
+
+		status := properties["status"].(map[string]any)
+		assert.Equal(t, []any{"A", "B", nil}, status["enum"])
+		for _, option := range status["oneOf"].([]any) {
+			assert.Contains(t, option, "const")
+			assert.NotContains(t, option, "default")
+		}
+	}
+
+	// yaml is converted the same way, numbers stay numbers
+	var yaml_document map[string]any
+	assert.NoError(t, yaml.Unmarshal(schema_as_yaml, &yaml_document))
+	assert.Equal(t, openAPI31SchemaDialect, yaml_document["jsonSchemaDialect"])
+	for _, schema := range yaml_document["components"].(map[string]any)["schemas"].(map[string]any) {
+		age := schema.(map[string]any)["properties"].(map[string]any)["age"].(map[string]any)
+		assert.Equal(t, 150, age["exclusiveMaximum"])
+	}
+}
+
+func TestConvertDocumentTo31_KeepsUserData(t *testing.T) {
+	t.Parallel()
+
+	document, err := decodeJSONDocument([]byte(`{
+		"openapi": "3.1.0",
+		"jsonSchemaDialect": "https://example.com/dialect",
+		"components": {"examples": {"user": {"value": {"schema": {"nullable": true, "type": "string"}}}}}
+	}`))
+	assert.NoError(t, err)
+	convertDocumentTo31(document)
+
+	converted, err := json.Marshal(document)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"openapi": "3.1.0",
+		"jsonSchemaDialect": "https://example.com/dialect",
+		"components": {"examples": {"user": {"value": {"schema": {"nullable": true, "type": "string"}}}}}
+	}`, string(converted))
+}
+
+func TestConvertDocumentTo31_KeepsOrder(t *testing.T) {
+	t.Parallel()
+
+	document, err := decodeJSONDocument([]byte(`{
+		"openapi": "3.1.0",
+		"jsonSchemaDialect": "https://example.com/dialect",
+		"paths": {"/users": {"get": {"parameters": [{"in": "query", "name": "age", "schema": {"$ref": "#/components/schemas/Age"}}]}}},
+		"components": {"schemas": {"Age": {"exclusiveMaximum": true, "maximum": 150, "minimum": 0, "nullable": true, "type": "integer", "example": 18}}}
+	}`))
+	assert.NoError(t, err)
+	convertDocumentTo31(document)
+
+	// the converted keywords take the place of the original ones, references stay bare
+	converted, err := json.Marshal(document)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"openapi":"3.1.0","jsonSchemaDialect":"https://example.com/dialect",`+
+		`"paths":{"/users":{"get":{"parameters":[{"in":"query","name":"age","schema":{"$ref":"#/components/schemas/Age"}}]}}},`+
+		`"components":{"schemas":{"Age":{"$schema":"https://example.com/dialect","exclusiveMaximum":150,"minimum":0,"type":["integer","null"],"examples":[18]}}}}`,
+		string(converted))
+
+	converted, err = yaml.Marshal(document)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(converted), "openapi: 3.1.0\njsonSchemaDialect: https://example.com/dialect\npaths:"))
+}
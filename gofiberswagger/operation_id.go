@@ -0,0 +1,214 @@
+package gofiberswagger
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Generated operationIds of the operations without one                         ///
+/// ---------------------------------------------------------------------------- ///
+
+// OperationIDRoute describes the operation, whose operationId is being generated
+type OperationIDRoute struct {
+	// HTTP method of the operation ("GET")
+	Method string
+	// OpenAPI path of the operation ("/users/{id}")
+	Path string
+	// Name of the fiber route (`.Name("...")`)
+	Name string
+	// Full name of the handler function ("github.com/x/handlers.GetUser"), empty when unknown
+	Handler string
+}
+
+// OperationIDStrategy returns the operationId of the operation, in any casing (it gets split into words and
+// re-cased by Config.OperationIDCasing). Returning "" leaves the operation without an operationId.
+type OperationIDStrategy func(route OperationIDRoute) string
+
+// OperationIDFromRouteName uses the name of the fiber route (`app.Get(...).Name("getUser")`)
+func OperationIDFromRouteName(route OperationIDRoute) string {
+	return route.Name
+}
+
+var anonymousFuncRegex = regexp.MustCompile(`^(func|gowrap)\d+$`)
+
+// OperationIDFromHandler uses the name of the handler function (`GetUser`, `(*UserService).List` -> `List`).
+// Anonymous functions are ignored.
+func OperationIDFromHandler(route OperationIDRoute) string {
+	// `pkg.Get[...]` -> `pkg.Get`
+	name, _, _ := strings.Cut(strings.TrimSuffix(route.Handler, "-fm"), "[")
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = name[strings.LastIndex(name, ".")+1:]
+	if anonymousFuncRegex.MatchString(name) {
+		return ""
+	}
+	return name
+}
+
+// OperationIDFromMethodAndPath uses the method and the path of the operation (`GET /users/{id}` -> `getUsersById`)
+func OperationIDFromMethodAndPath(route OperationIDRoute) string {
+	words := []string{strings.ToLower(route.Method)}
+	params := []string{}
+	for _, segment := range strings.Split(route.Path, "/") {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params = append(params, strings.Trim(segment, "{}"))
+			continue
+		}
+		words = append(words, segment)
+	}
+	if len(params) > 0 {
+		words = append(words, "by", strings.Join(params, " and "))
+	}
+	return strings.Join(words, " ")
+}
+
+// OperationIDAuto uses the route name, the handler name, or the method and path (the first one available)
+func OperationIDAuto(route OperationIDRoute) string {
+	for _, strategy := range []OperationIDStrategy{OperationIDFromRouteName, OperationIDFromHandler, OperationIDFromMethodAndPath} {
+		if id := strategy(route); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// OperationIDCasing joins the words of the generated operationId
+type OperationIDCasing func(words []string) string
+
+// OperationIDCamelCase -> `getUserById`
+func OperationIDCamelCase(words []string) string {
+	if len(words) == 0 {
+		return ""
+	}
+	return strings.ToLower(words[0]) + OperationIDPascalCase(words[1:])
+}
+
+// OperationIDPascalCase -> `GetUserById`
+func OperationIDPascalCase(words []string) string {
+	result := strings.Builder{}
+	for _, word := range words {
+		runes := []rune(strings.ToLower(word))
+		if len(runes) == 0 {
+			continue
+		}
+		runes[0] = unicode.ToUpper(runes[0])
+		result.WriteString(string(runes))
+	}
+	return result.String()
+}
+
+// OperationIDSnakeCase -> `get_user_by_id`
+func OperationIDSnakeCase(words []string) string {
+	return strings.ToLower(strings.Join(words, "_"))
+}
+
+// OperationIDKebabCase -> `get-user-by-id`
+func OperationIDKebabCase(words []string) string {
+	return strings.ToLower(strings.Join(words, "-"))
+}
+
+// splits the identifier into words (on non-alphanumeric characters and lower -> upper case transitions)
+func splitOperationIDWords(id string) []string {
+	words := []string{}
+	word := []rune{}
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = []rune{}
+		}
+	}
+	runes := []rune(id)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && len(word) > 0:
+			previous := word[len(word)-1]
+			next_is_lower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// `userID` -> user ID, `HTTPServer` -> HTTP Server
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && next_is_lower) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// returns the full name of the handler function, or "" when it's not a function
+func getHandlerName(handler any) string {
+	value := reflect.ValueOf(handler)
+	if !value.IsValid() || value.Kind() != reflect.Func || value.IsNil() {
+		return ""
+	}
+	if runtime_func := runtime.FuncForPC(value.Pointer()); runtime_func != nil {
+		return runtime_func.Name()
+	}
+	return ""
+}
+
+// generates the operationIds of the route's variants, which don't have one. Variants of the same route
+// (optional path parameters) sharing the generated id get suffixed by their parameters (`getUser` -> `getUserById`).
+func generateOperationIDs(config *Config, route OperationIDRoute, variants []fiberPathVariant, operations []*RouteInfo) {
+	if config.OperationIDStrategy == nil {
+		return
+	}
+	casing := config.OperationIDCasing
+	if casing == nil {
+		casing = OperationIDCamelCase
+	}
+
+	ids := make([][]string, len(variants))
+	counts := map[string]int{}
+	for i, variant := range variants {
+		route.Path = variant.Path
+		ids[i] = splitOperationIDWords(config.OperationIDStrategy(route))
+		counts[strings.Join(ids[i], " ")]++
+	}
+	for i, variant := range variants {
+		if operations[i].OperationID != "" || len(ids[i]) == 0 {
+			continue
+		}
+		words := ids[i]
+		if counts[strings.Join(words, " ")] > 1 && len(variant.Params) > 0 {
+			words = append(words, "by")
+			for j, param := range variant.Params {
+				if j > 0 {
+					words = append(words, "and")
+				}
+				words = append(words, splitOperationIDWords(param.Name)...)
+			}
+		}
+		operations[i].OperationID = casing(words)
+	}
+}
+
+// returns an error describing the operations sharing an operationId
+func checkOperationIDsUniqueness(document *SwaggerConfig) error {
+	seen := map[string]string{}
+	duplicates := []string{}
+	forEachOperation(document, func(method string, path string, operation *Operation) {
+		if operation.OperationID == "" {
+			return
+		}
+		current := method + " " + path
+		if previous, ok := seen[operation.OperationID]; ok {
+			duplicates = append(duplicates, fmt.Sprintf("%q is used by both %s and %s", operation.OperationID, previous, current))
+			return
+		}
+		seen[operation.OperationID] = current
+	})
+	if len(duplicates) > 0 {
+		return fmt.Errorf("gofiber-swagger: duplicate operationIds (set them explicitly, or use a different Config.OperationIDStrategy) -> %s", strings.Join(duplicates, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package gofiberswagger
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func GetOperationIDUser(c fiber.Ctx) error {
+	return c.SendStatus(200)
+}
+
+func ListOperationIDItems(c fiber.Ctx) error {
+	return c.SendStatus(200)
+}
+
+type operationIDService struct{}
+
+func (operationIDService) DeleteUser(c fiber.Ctx) error {
+	return c.SendStatus(204)
+}
+
+func TestSplitOperationIDWords(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id       string
+		expected []string
+	}{
+		{"getUser", []string{"get", "User"}},
+		{"GetUserByID", []string{"Get", "User", "By", "ID"}},
+		{"HTTPServer", []string{"HTTP", "Server"}},
+		{"get_user-by id", []string{"get", "user", "by", "id"}},
+		{"get /api/v1/users", []string{"get", "api", "v1", "users"}},
+		{"", []string{}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, splitOperationIDWords(tc.id), tc.id)
+	}
+}
+
+func TestOperationIDStrategies(t *testing.T) {
+	t.Parallel()
+
+	route := OperationIDRoute{Method: "GET", Path: "/api/v1/users/{id}", Name: "fetchUser", Handler: "github.com/x/handlers.(*Service).GetUser-fm"}
+	assert.Equal(t, "fetchUser", OperationIDFromRouteName(route))
+	assert.Equal(t, "GetUser", OperationIDFromHandler(route))
+	assert.Equal(t, "", OperationIDFromHandler(OperationIDRoute{Handler: "main.main.func1"}))
+	assert.Equal(t, "Get", OperationIDFromHandler(OperationIDRoute{Handler: "github.com/x/handlers.Get[go.shape.int]"}))
+	assert.Equal(t, "getApiV1UsersById", OperationIDCamelCase(splitOperationIDWords(OperationIDFromMethodAndPath(route))))
+	assert.Equal(t, "fetchUser", OperationIDAuto(route))
+	assert.Equal(t, "GetUser", OperationIDAuto(OperationIDRoute{Handler: route.Handler, Method: "GET", Path: "/"}))
+	assert.Equal(t, "get", OperationIDAuto(OperationIDRoute{Method: "GET", Path: "/"}))
+
+	words := []string{"get", "User", "By", "ID"}
+	assert.Equal(t, "getUserById", OperationIDCamelCase(words))
+	assert.Equal(t, "GetUserById", OperationIDPascalCase(words))
+	assert.Equal(t, "get_user_by_id", OperationIDSnakeCase(words))
+	assert.Equal(t, "get-user-by-id", OperationIDKebabCase(words))
+}
+
+func TestRegister_OperationIDs(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	router.Get("/users/:id", nil, GetOperationIDUser)
+	router.Get("/items/:id?", nil, ListOperationIDItems)
+	router.Post("/users", nil, func(c fiber.Ctx) error { return c.SendStatus(201) }).Name("createUser")
+	router.Delete("/users/:id", nil, operationIDService{}.DeleteUser)
+	router.Put("/users/:id", nil, func(c fiber.Ctx) error { return c.SendStatus(200) })
+	router.Patch("/users/:id", &RouteInfo{OperationID: "explicit"}, GetOperationIDUser)
+
+	config := &Config{OperationIDStrategy: OperationIDAuto}
+	_, err := registry.generate(app, config)
+	assert.NoError(t, err)
+
+	paths := config.Swagger.Paths
+	assert.Equal(t, "getOperationIdUser", paths.Find("/users/{id}").Get.OperationID)
+	assert.Equal(t, "listOperationIdItems", paths.Find("/items").Get.OperationID)
+	assert.Equal(t, "listOperationIdItemsById", paths.Find("/items/{id}").Get.OperationID)
+	assert.Equal(t, "createUser", paths.Find("/users").Post.OperationID)
+	assert.Equal(t, "deleteUser", paths.Find("/users/{id}").Delete.OperationID)
+	assert.Equal(t, "putUsersById", paths.Find("/users/{id}").Put.OperationID)
+	assert.Equal(t, "explicit", paths.Find("/users/{id}").Patch.OperationID)
+
+	t.Run("casing", func(t *testing.T) {
+		config := &Config{OperationIDStrategy: OperationIDFromMethodAndPath, OperationIDCasing: OperationIDSnakeCase}
+		_, err := registry.generate(app, config)
+		assert.NoError(t, err)
+		assert.Equal(t, "get_users_by_id", config.Swagger.Paths.Find("/users/{id}").Get.OperationID)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		config := &Config{}
+		_, err := registry.generate(app, config)
+		assert.NoError(t, err)
+		assert.Empty(t, config.Swagger.Paths.Find("/users/{id}").Get.OperationID)
+	})
+}
+
+func TestRegister_OperationIDsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	router.Get("/users/:id", nil, GetOperationIDUser)
+	router.Get("/accounts/:id", nil, GetOperationIDUser)
+
+	_, err := registry.generate(app, &Config{OperationIDStrategy: OperationIDFromHandler})
+	assert.ErrorContains(t, err, `"getOperationIdUser" is used by both GET /accounts/{id} and GET /users/{id}`)
+}
@@ -0,0 +1,139 @@
+package gofiberswagger
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Parameters declared by a struct, the same way fiber binds them               ///
+/// (c.Bind().URI / Query / Header / Cookie)                                     ///
+/// ---------------------------------------------------------------------------- ///
+
+// tag -> parameter location, in the order in which they are looked up
+var parameterTags = []struct {
+	Tag string
+	In  string
+}{
+	{"uri", openapi3.ParameterInPath},
+	{"query", openapi3.ParameterInQuery},
+	{"header", openapi3.ParameterInHeader},
+	{"cookie", openapi3.ParameterInCookie},
+}
+
+// NewParametersFrom generates parameters from the fields of T tagged with `uri`, `query`, `header` or `cookie`
+// and stores the generated components inside the DefaultRegistry.
+//
+//	type ListUsersParams struct {
+//		Page   int      `query:"page" default:"1"`
+//		Roles  []string `query:"roles"`
+//		APIKey string   `header:"X-Api-Key" validate:"required"`
+//	}
+//
+//	Parameters: gofiberswagger.NewParametersFrom[ListUsersParams]()
+func NewParametersFrom[T any]() Parameters {
+	return NewParametersFromIn[T](DefaultRegistry)
+}
+
+// NewParametersFromIn generates parameters from the fields of T and stores the generated components inside the registry
+func NewParametersFromIn[T any](registry *Registry) Parameters {
+	registry.schemasMutex.Lock()
+	defer registry.schemasMutex.Unlock()
+
+	return registry.generateParameters(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// call only while holding r.schemasMutex!
+func (r *Registry) generateParameters(t reflect.Type) Parameters {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	parameters := Parameters{}
+	if t.Kind() != reflect.Struct {
+		return parameters
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		// fiber binds embedded structs as if their fields were declared directly
+		if field.Anonymous {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				parameters = append(parameters, r.generateParameters(fieldType)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		for _, parameterTag := range parameterTags {
+			tag, ok := field.Tag.Lookup(parameterTag.Tag)
+			if !ok {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+			if name == "-" {
+				break
+			}
+			if name == "" {
+				name = field.Name
+			}
+
+			parameter := r.generateParameter(field, name, parameterTag.In)
+			if parameter != nil {
+				parameters = append(parameters, parameter)
+			}
+			break
+		}
+	}
+
+	return parameters
+}
+
+// call only while holding r.schemasMutex!
+func (r *Registry) generateParameter(field reflect.StructField, name string, in string) *ParameterRef {
+	_, result, required := r.generateFieldSchema(field)
+	if result == nil {
+		return nil
+	}
+	schema := result.Value
+	schema.Title = ""
+	schema.Nullable = false
+	// the zero value defaults make sense for bodies, not for parameters which weren't sent
+	schema.Default = nil
+	if defaultTag, ok := field.Tag.Lookup(r.tagName("default")); ok {
+		schema.Default = parseTagValue(schema, defaultTag)
+	}
+
+	// `doc:"..."` & `deprecated:"true"` describe the parameter itself
+	parameter := &Parameter{
+		Name:        name,
+		In:          in,
+		Description: schema.Description,
+		Deprecated:  schema.Deprecated,
+		Required:    required || in == openapi3.ParameterInPath,
+		Schema:      result,
+	}
+	schema.Description = ""
+	schema.Deprecated = false
+	if schema.Type.Is("array") {
+		explode := true
+		switch in {
+		case openapi3.ParameterInQuery, openapi3.ParameterInCookie:
+			parameter.Style = openapi3.SerializationForm
+		case openapi3.ParameterInPath, openapi3.ParameterInHeader:
+			parameter.Style = openapi3.SerializationSimple
+			explode = false
+		}
+		parameter.Explode = &explode
+	}
+
+	return &ParameterRef{Value: parameter}
+}
@@ -0,0 +1,84 @@
+package gofiberswagger
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+)
+
+type PaginationParams struct {
+	Page  int `query:"page" default:"1"`
+	Limit int `query:"limit" validate:"max=100" default:"20"`
+}
+
+type ListParams struct {
+	PaginationParams
+	ID       int      `uri:"id"`
+	Roles    []string `query:"roles" default:"admin,user"`
+	Enum     TestEnum `query:"enum"`
+	APIKey   string   `header:"X-Api-Key" validate:"required"`
+	Session  string   `cookie:"session"`
+	Ignored  string   `query:"-"`
+	NotParam string   `json:"not_param"`
+	hidden   string   `query:"hidden"` //nolint:unused
+}
+
+func TestNewParametersFrom(t *testing.T) {
+	t.Parallel()
+
+	parameters := NewParametersFromIn[ListParams](NewRegistry(nil))
+	assert.Len(t, parameters, 7)
+
+	page := parameters.GetByInAndName(openapi3.ParameterInQuery, "page")
+	assert.NotNil(t, page)
+	assert.False(t, page.Required)
+	assert.Equal(t, "integer", (*page.Schema.Value.Type)[0])
+	assert.Equal(t, int64(1), page.Schema.Value.Default)
+
+	limit := parameters.GetByInAndName(openapi3.ParameterInQuery, "limit")
+	assert.NotNil(t, limit)
+	assert.Equal(t, float64(100), *limit.Schema.Value.Max)
+	assert.Equal(t, int64(20), limit.Schema.Value.Default)
+
+	id := parameters.GetByInAndName(openapi3.ParameterInPath, "id")
+	assert.NotNil(t, id)
+	assert.True(t, id.Required)
+	assert.Nil(t, id.Schema.Value.Default)
+
+	roles := parameters.GetByInAndName(openapi3.ParameterInQuery, "roles")
+	assert.NotNil(t, roles)
+	assert.Equal(t, "array", (*roles.Schema.Value.Type)[0])
+	assert.Equal(t, openapi3.SerializationForm, roles.Style)
+	assert.True(t, *roles.Explode)
+	assert.Equal(t, []any{"admin", "user"}, roles.Schema.Value.Default)
+
+	enum := parameters.GetByInAndName(openapi3.ParameterInQuery, "enum")
+	assert.NotNil(t, enum)
+	assert.Equal(t, []any{TestEnumA, TestEnumB}, enum.Schema.Value.Enum)
+
+	apiKey := parameters.GetByInAndName(openapi3.ParameterInHeader, "X-Api-Key")
+	assert.NotNil(t, apiKey)
+	assert.True(t, apiKey.Required)
+
+	session := parameters.GetByInAndName(openapi3.ParameterInCookie, "session")
+	assert.NotNil(t, session)
+	assert.False(t, session.Required)
+
+	assert.Nil(t, parameters.GetByInAndName(openapi3.ParameterInQuery, "Ignored"))
+	assert.Nil(t, parameters.GetByInAndName(openapi3.ParameterInQuery, "hidden"))
+
+	for _, parameter := range parameters {
+		// enum option schemas keep the go typed values, which kin-openapi can't validate
+		if parameter.Value.Name == "enum" {
+			continue
+		}
+		assert.NoError(t, parameter.Value.Validate(t.Context()), parameter.Value.Name)
+	}
+}
+
+func TestNewParametersFrom_NonStruct(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, NewParametersFromIn[string](NewRegistry(nil)))
+}
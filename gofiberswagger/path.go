@@ -0,0 +1,347 @@
+package gofiberswagger
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Translation of fiber route paths (https://docs.gofiber.io/guide/routing)     ///
+/// into openapi path templates & parameters                                     ///
+/// ---------------------------------------------------------------------------- ///
+
+type fiberPathConstraint struct {
+	Name string
+	Data []string
+}
+
+type fiberPathParam struct {
+	// name used in the openapi path template
+	Name string
+	// name used by fiber (c.Params(name)), wildcards are numbered (e.g. "*1", "+2")
+	FiberName   string
+	Optional    bool
+	Greedy      bool
+	Constraints []fiberPathConstraint
+}
+
+// either constant part of the path, or a parameter
+type fiberPathSegment struct {
+	Const string
+	Param *fiberPathParam
+}
+
+// chars which end the name of a parameter
+func isFiberParamEndChar(char byte) bool {
+	switch char {
+	case '?', ':', '\\', '/', '-', '.':
+		return true
+	}
+	return false
+}
+
+func parseFiberPath(pattern string) []fiberPathSegment {
+	segments := []fiberPathSegment{}
+	const_part := strings.Builder{}
+	flush_const_part := func() {
+		if const_part.Len() > 0 {
+			segments = append(segments, fiberPathSegment{Const: const_part.String()})
+			const_part.Reset()
+		}
+	}
+
+	wildcard_count := 0
+	plus_count := 0
+	for i := 0; i < len(pattern); {
+		char := pattern[i]
+		switch {
+		// escaped char, e.g. `\:` -> `:`
+		case char == '\\' && i+1 < len(pattern):
+			const_part.WriteByte(pattern[i+1])
+			i += 2
+
+		// greedy parameters
+		case char == '*' || char == '+':
+			flush_const_part()
+			param := &fiberPathParam{Greedy: true, Optional: char == '*'}
+			if char == '*' {
+				wildcard_count++
+				param.FiberName = "*" + strconv.Itoa(wildcard_count)
+				param.Name = "wildcard" + strconv.Itoa(wildcard_count)
+			} else {
+				plus_count++
+				param.FiberName = "+" + strconv.Itoa(plus_count)
+				param.Name = "plus" + strconv.Itoa(plus_count)
+			}
+			segments = append(segments, fiberPathSegment{Param: param})
+			i++
+
+		// named parameters
+		case char == ':':
+			end := i + 1
+			constraint_start := -1
+			constraint_end := -1
+			for ; end < len(pattern); end++ {
+				if constraint_start == -1 && pattern[end] == '<' && pattern[end-1] != '\\' {
+					constraint_start = end
+					continue
+				}
+				if constraint_start != -1 && constraint_end == -1 {
+					if pattern[end] == '>' && pattern[end-1] != '\\' {
+						constraint_end = end
+					}
+					continue
+				}
+				if isFiberParamEndChar(pattern[end]) {
+					break
+				}
+			}
+
+			name := pattern[i+1 : end]
+			param := &fiberPathParam{}
+			if constraint_start != -1 && constraint_end != -1 {
+				name = pattern[i+1 : constraint_start]
+				param.Constraints = parseFiberPathConstraints(pattern[constraint_start+1 : constraint_end])
+			}
+			param.Name = removeFiberEscapeChars(name)
+			param.FiberName = param.Name
+			if param.Name == "" {
+				const_part.WriteByte(char)
+				i++
+				continue
+			}
+			if end < len(pattern) && pattern[end] == '?' {
+				param.Optional = true
+				end++
+			}
+
+			flush_const_part()
+			segments = append(segments, fiberPathSegment{Param: param})
+			i = end
+
+		default:
+			const_part.WriteByte(char)
+			i++
+		}
+	}
+	flush_const_part()
+
+	// `*` / `+` are not valid in openapi parameter names, name them "wildcard" / "plus" and number them only when needed
+	for _, segment := range segments {
+		if segment.Param == nil || !segment.Param.Greedy {
+			continue
+		}
+		if segment.Param.FiberName == "*1" && wildcard_count == 1 {
+			segment.Param.Name = "wildcard"
+		}
+		if segment.Param.FiberName == "+1" && plus_count == 1 {
+			segment.Param.Name = "plus"
+		}
+	}
+
+	return segments
+}
+
+// parses `int;min(1)` / `regex(\d+)` / `range(1,10)` constraints
+func parseFiberPathConstraints(constraints string) []fiberPathConstraint {
+	result := []fiberPathConstraint{}
+	for _, constraint := range splitNonEscaped(constraints, ';') {
+		data_start := strings.IndexByte(constraint, '(')
+		data_end := strings.LastIndexByte(constraint, ')')
+		if data_start == -1 || data_end == -1 || data_end < data_start {
+			result = append(result, fiberPathConstraint{Name: constraint})
+			continue
+		}
+
+		parsed := fiberPathConstraint{Name: constraint[:data_start]}
+		data := constraint[data_start+1 : data_end]
+		if parsed.Name == "regex" {
+			parsed.Data = []string{data}
+		} else {
+			for _, part := range splitNonEscaped(data, ',') {
+				parsed.Data = append(parsed.Data, removeFiberEscapeChars(part))
+			}
+		}
+		result = append(result, parsed)
+	}
+	return result
+}
+
+func splitNonEscaped(s string, separator byte) []string {
+	result := []string{}
+	last := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == separator && (i == 0 || s[i-1] != '\\') {
+			result = append(result, s[last:i])
+			last = i + 1
+		}
+	}
+	return append(result, s[last:])
+}
+
+func removeFiberEscapeChars(s string) string {
+	result := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		result.WriteByte(s[i])
+	}
+	return result.String()
+}
+
+type fiberPathVariant struct {
+	Path   string
+	Params []fiberPathParam
+}
+
+// converts the fiber route path into openapi path templates (`/users/:id<int>` -> `/users/{id}`).
+// Since openapi doesn't support optional path parameters, the variants omitting the trailing optional parameters
+// are returned as well, in the order fiber fills the parameters (`/:a?/:b?` -> `/{a}/{b}`, `/{a}` and `/`).
+// Optional parameters followed by anything else than other optional parameters separated by `/` are always present,
+// since their omission would lead to ambiguous templates (or ones fiber never matches).
+// Unsupported (documented only by the full variant):
+//   - optional parameters in the middle of the path: `/users/:id?/posts` is matched by fiber as `/users//posts`
+//     (never `/users/posts`), which can't be expressed by a template
+//   - omitted leading parameters of a segment: `/:lang?-:region?` gets `/{lang}-{region}` and `/{lang}-`
+//     (`/en-`, which fiber matches as well), but not `/-{region}` or `/-`
+func convertFiberPath(pattern string) []fiberPathVariant {
+	segments := parseFiberPath(pattern)
+
+	variants := []fiberPathVariant{newFiberPathVariant(segments)}
+	for end := len(segments) - 1; end >= 0; end-- {
+		segment := segments[end]
+		if segment.Param == nil && segment.Const == "/" {
+			continue
+		}
+		if segment.Param == nil || !segment.Param.Optional {
+			break
+		}
+
+		// fiber makes the delimiter in front of an omitted parameter optional (`/users/:id?` matches `/users`)
+		truncated := slices.Clone(segments[:end])
+		if last := len(truncated) - 1; last >= 0 && truncated[last].Param == nil {
+			truncated[last].Const = strings.TrimSuffix(truncated[last].Const, "/")
+		}
+		variant := newFiberPathVariant(truncated)
+		if variant.Path != variants[len(variants)-1].Path {
+			variants = append(variants, variant)
+		}
+	}
+
+	return variants
+}
+
+func newFiberPathVariant(segments []fiberPathSegment) fiberPathVariant {
+	path := strings.Builder{}
+	params := []fiberPathParam{}
+	for _, segment := range segments {
+		if segment.Param == nil {
+			path.WriteString(segment.Const)
+			continue
+		}
+		path.WriteString("{" + segment.Param.Name + "}")
+		params = append(params, *segment.Param)
+	}
+
+	variant_path := path.String()
+	if variant_path == "" {
+		variant_path = "/"
+	}
+	return fiberPathVariant{Path: variant_path, Params: params}
+}
+
+// translates the constraints of the parameter into it's schema
+func newFiberPathParamSchema(param fiberPathParam) *Schema {
+	schema := NewStringSchema()
+	if param.Greedy {
+		schema.Description = "may contain `/`"
+	}
+	for _, constraint := range param.Constraints {
+		switch constraint.Name {
+		case "int":
+			schema.Type = &Types{"integer"}
+		case "bool":
+			schema.Type = &Types{"boolean"}
+		case "float":
+			schema.Type = &Types{"number"}
+		case "alpha":
+			schema.Pattern = "^[a-zA-Z]+$"
+		case "guid":
+			schema.Format = "uuid"
+		case "minLen":
+			if value, ok := parseFiberConstraintUint(constraint, 0); ok {
+				schema.MinLength = value
+			}
+		case "maxLen":
+			if value, ok := parseFiberConstraintUint(constraint, 0); ok {
+				schema.MaxLength = &value
+			}
+		case "len":
+			if value, ok := parseFiberConstraintUint(constraint, 0); ok {
+				schema.MinLength = value
+				schema.MaxLength = &value
+			}
+		case "betweenLen":
+			if value, ok := parseFiberConstraintUint(constraint, 0); ok {
+				schema.MinLength = value
+			}
+			if value, ok := parseFiberConstraintUint(constraint, 1); ok {
+				schema.MaxLength = &value
+			}
+		case "min":
+			schema.Type = &Types{"integer"}
+			if value, ok := parseFiberConstraintFloat(constraint, 0); ok {
+				schema.Min = &value
+			}
+		case "max":
+			schema.Type = &Types{"integer"}
+			if value, ok := parseFiberConstraintFloat(constraint, 0); ok {
+				schema.Max = &value
+			}
+		case "range":
+			schema.Type = &Types{"integer"}
+			if value, ok := parseFiberConstraintFloat(constraint, 0); ok {
+				schema.Min = &value
+			}
+			if value, ok := parseFiberConstraintFloat(constraint, 1); ok {
+				schema.Max = &value
+			}
+		case "datetime":
+			if len(constraint.Data) == 0 {
+				continue
+			}
+			switch constraint.Data[0] {
+			case time.DateOnly:
+				schema.Format = "date"
+			case time.RFC3339, time.RFC3339Nano:
+				schema.Format = "date-time"
+			default:
+				schema.Description = "datetime in the `" + constraint.Data[0] + "` format"
+			}
+		case "regex":
+			if len(constraint.Data) > 0 {
+				schema.Pattern = constraint.Data[0]
+			}
+		}
+	}
+	return schema
+}
+
+func parseFiberConstraintUint(constraint fiberPathConstraint, index int) (uint64, bool) {
+	if index >= len(constraint.Data) {
+		return 0, false
+	}
+	value, err := strconv.ParseUint(strings.TrimSpace(constraint.Data[index]), 10, 64)
+	return value, err == nil
+}
+
+func parseFiberConstraintFloat(constraint fiberPathConstraint, index int) (float64, bool) {
+	if index >= len(constraint.Data) {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(constraint.Data[index]), 64)
+	return value, err == nil
+}
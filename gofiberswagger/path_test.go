@@ -0,0 +1,162 @@
+package gofiberswagger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertFiberPath_Constraints(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		fiberPath    string
+		expectedPath string
+		check        func(t *testing.T, schema *Schema)
+	}{
+		{"/users/:id<int>", "/users/{id}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "integer", (*schema.Type)[0])
+		}},
+		{"/flags/:enabled<bool>", "/flags/{enabled}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "boolean", (*schema.Type)[0])
+		}},
+		{"/prices/:price<float>", "/prices/{price}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "number", (*schema.Type)[0])
+		}},
+		{"/names/:name<alpha>", "/names/{name}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "string", (*schema.Type)[0])
+			assert.Equal(t, "^[a-zA-Z]+$", schema.Pattern)
+		}},
+		{"/items/:id<guid>", "/items/{id}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "uuid", schema.Format)
+		}},
+		{"/adults/:age<min(18)>", "/adults/{age}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "integer", (*schema.Type)[0])
+			assert.Equal(t, float64(18), *schema.Min)
+			assert.Nil(t, schema.Max)
+		}},
+		{"/ages/:age<min(18);max(99)>", "/ages/{age}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, float64(18), *schema.Min)
+			assert.Equal(t, float64(99), *schema.Max)
+		}},
+		{"/pages/:page<range(1,10)>", "/pages/{page}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "integer", (*schema.Type)[0])
+			assert.Equal(t, float64(1), *schema.Min)
+			assert.Equal(t, float64(10), *schema.Max)
+		}},
+		{"/codes/:code<len(3)>", "/codes/{code}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, uint64(3), schema.MinLength)
+			assert.Equal(t, uint64(3), *schema.MaxLength)
+		}},
+		{"/users/:name<minLen(2);maxLen(20)>", "/users/{name}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, uint64(2), schema.MinLength)
+			assert.Equal(t, uint64(20), *schema.MaxLength)
+		}},
+		{"/users/:name<betweenLen(2,20)>", "/users/{name}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, uint64(2), schema.MinLength)
+			assert.Equal(t, uint64(20), *schema.MaxLength)
+		}},
+		{"/posts/:slug<regex(^[a-z\\-]+$)>/comments", "/posts/{slug}/comments", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "string", (*schema.Type)[0])
+			assert.Equal(t, "^[a-z\\-]+$", schema.Pattern)
+		}},
+		{"/days/:d<datetime(2006\\-01\\-02)>", "/days/{d}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "string", (*schema.Type)[0])
+			assert.Equal(t, "date", schema.Format)
+		}},
+		{"/times/:t<datetime(15:04)>", "/times/{t}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "", schema.Format)
+			assert.Contains(t, schema.Description, "15:04")
+		}},
+		{"/plain/:name", "/plain/{name}", func(t *testing.T, schema *Schema) {
+			assert.Equal(t, "string", (*schema.Type)[0])
+			assert.Empty(t, schema.Format)
+			assert.Empty(t, schema.Pattern)
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fiberPath, func(t *testing.T) {
+			t.Parallel()
+			variants := convertFiberPath(tc.fiberPath)
+			assert.Len(t, variants, 1)
+			assert.Equal(t, tc.expectedPath, variants[0].Path)
+			assert.Len(t, variants[0].Params, 1)
+			tc.check(t, newFiberPathParamSchema(variants[0].Params[0]))
+		})
+	}
+}
+
+// cases taken from https://docs.gofiber.io/guide/routing
+func TestConvertFiberPath_Variants(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		fiberPath      string
+		expectedPaths  []string
+		expectedParams [][]string
+	}{
+		{"/", []string{"/"}, [][]string{{}}},
+		{"/api/list", []string{"/api/list"}, [][]string{{}}},
+		{"/user/:name", []string{"/user/{name}"}, [][]string{{"name"}}},
+		{"/user/:name?", []string{"/user/{name}", "/user"}, [][]string{{"name"}, {}}},
+		{"/:name?", []string{"/{name}", "/"}, [][]string{{"name"}, {}}},
+		{"/api/*", []string{"/api/{wildcard}", "/api"}, [][]string{{"wildcard"}, {}}},
+		{"/user/+", []string{"/user/{plus}"}, [][]string{{"plus"}}},
+		{"/v1/some/resource/name\\:customVerb", []string{"/v1/some/resource/name:customVerb"}, [][]string{{}}},
+		{"/v1/some/resource/:name\\:customVerb", []string{"/v1/some/resource/{name}:customVerb"}, [][]string{{"name"}}},
+		{"/plantae/:genus.:species", []string{"/plantae/{genus}.{species}"}, [][]string{{"genus", "species"}}},
+		{"/flights/:from-:to", []string{"/flights/{from}-{to}"}, [][]string{{"from", "to"}}},
+		{"/shop/product/color::color/size::size", []string{"/shop/product/color:{color}/size:{size}"}, [][]string{{"color", "size"}}},
+		{"/@:name", []string{"/@{name}"}, [][]string{{"name"}}},
+		{"/api/v1/:param/abc/*", []string{"/api/v1/{param}/abc/{wildcard}", "/api/v1/{param}/abc"}, [][]string{{"param", "wildcard"}, {"param"}}},
+		{"/+/+", []string{"/{plus1}/{plus2}"}, [][]string{{"plus1", "plus2"}}},
+		// only the trailing optional parameters get omitted, in the order fiber fills them
+		{"/*/prefix/*", []string{"/{wildcard1}/prefix/{wildcard2}", "/{wildcard1}/prefix"}, [][]string{{"wildcard1", "wildcard2"}, {"wildcard1"}}},
+		{"/:a?/:b?", []string{"/{a}/{b}", "/{a}", "/"}, [][]string{{"a", "b"}, {"a"}, {}}},
+		{"/api/:day/:month?/:year?", []string{"/api/{day}/{month}/{year}", "/api/{day}/{month}", "/api/{day}"}, [][]string{{"day", "month", "year"}, {"day", "month"}, {"day"}}},
+		{"/api/*/:param?", []string{"/api/{wildcard}/{param}", "/api/{wildcard}", "/api"}, [][]string{{"wildcard", "param"}, {"wildcard"}, {}}},
+		{"/test:optional?:optional2?", []string{"/test{optional}{optional2}", "/test{optional}", "/test"}, [][]string{{"optional", "optional2"}, {"optional"}, {}}},
+		{"/api/:day.:month?.:year?", []string{"/api/{day}.{month}.{year}", "/api/{day}.{month}."}, [][]string{{"day", "month", "year"}, {"day", "month"}}},
+		{"/:lang?-:region?", []string{"/{lang}-{region}", "/{lang}-"}, [][]string{{"lang", "region"}, {"lang"}}},
+		// optional parameters followed by required ones / constants are always present
+		{"/users/:id?/profile", []string{"/users/{id}/profile"}, [][]string{{"id"}}},
+		{"/users/:id?/posts", []string{"/users/{id}/posts"}, [][]string{{"id"}}},
+		{"/:a?/:b", []string{"/{a}/{b}"}, [][]string{{"a", "b"}}},
+		{"/foo:param?bar", []string{"/foo{param}bar"}, [][]string{{"param"}}},
+		{"/files/*/+", []string{"/files/{wildcard}/{plus}"}, [][]string{{"wildcard", "plus"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fiberPath, func(t *testing.T) {
+			t.Parallel()
+			variants := convertFiberPath(tc.fiberPath)
+			paths := []string{}
+			params := [][]string{}
+			for _, variant := range variants {
+				paths = append(paths, variant.Path)
+				names := []string{}
+				for _, param := range variant.Params {
+					names = append(names, param.Name)
+				}
+				params = append(params, names)
+			}
+			assert.Equal(t, tc.expectedPaths, paths)
+			assert.Equal(t, tc.expectedParams, params)
+		})
+	}
+}
+
+func TestConvertFiberPath_Wildcards(t *testing.T) {
+	t.Parallel()
+
+	variants := convertFiberPath("/files/*/+")
+	assert.Equal(t, "/files/{wildcard}/{plus}", variants[0].Path)
+	assert.Equal(t, "*1", variants[0].Params[0].FiberName)
+	assert.True(t, variants[0].Params[0].Optional)
+	assert.Equal(t, "+1", variants[0].Params[1].FiberName)
+	assert.False(t, variants[0].Params[1].Optional)
+
+	schema := newFiberPathParamSchema(variants[0].Params[0])
+	assert.Contains(t, schema.Description, "/")
+}
@@ -0,0 +1,253 @@
+package gofiberswagger
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Registry owns the routes, schemas and config used to generate a single openapi document.
+// Use a separate registry for every fiber.App you want to document independently
+// (e.g. a public and an admin API living inside the same process).
+type Registry struct {
+	// Config used by (*Registry).Register
+	// default: DefaultConfig
+	Config *Config
+
+	mutex      sync.Mutex
+	routesInfo map[string]*RouteInfo
+	// functions of typed handlers (the actual handlers registered in fiber are just wrappers)
+	routesHandlers map[string]any
+	// groups of the routes, applied when the document gets generated (see GroupInfo)
+	routesGroups map[string][]*routerGroup
+	// all the router groups, in the order of their creation
+	groups []*routerGroup
+
+	// guards the whole schema generation, not only the access to the map.
+	// generateSchema temporarily stores placeholders (to prevent infinite recursion),
+	// which must never be observed by other goroutines.
+	schemasMutex sync.Mutex
+	schemas      map[string]*SchemaRef
+	schemasTypes map[string]reflect.Type
+	// components created using CreateSchema / CreateSchemaIn, which are emitted even when no route references them
+	createdSchemas map[string]bool
+	// provisional component names, assigned once per type (see Config.SchemaNaming and nameSchemaComponents)
+	schemasNames          map[reflect.Type]string
+	anonymousSchemasHints map[reflect.Type]string
+	// names given to the components by the generated documents (document name -> provisional name)
+	schemasDocumentNames map[string]string
+	// schemas registered using RegisterTypeSchema
+	typeSchemas map[reflect.Type]func() *Schema
+
+	registerMutex sync.Mutex
+
+	// routes of the last generated document, used by the validation middlewares
+	validationMutex  sync.RWMutex
+	validationRoutes []*validationRoute
+}
+
+// DefaultRegistry is used by all the package level functions (Register, RegisterRoute, CreateSchema, NewRouter, ...)
+var DefaultRegistry = NewRegistry(nil)
+
+func NewRegistry(config *Config) *Registry {
+	if config == nil {
+		config = &Config{}
+		*config = DefaultConfig
+	}
+	return &Registry{
+		Config:         config,
+		routesInfo:     make(map[string]*RouteInfo),
+		routesHandlers: make(map[string]any),
+		routesGroups:   make(map[string][]*routerGroup),
+		schemas:        make(map[string]*SchemaRef),
+		schemasTypes:   make(map[string]reflect.Type),
+		createdSchemas: make(map[string]bool),
+
+		schemasNames:          make(map[reflect.Type]string),
+		anonymousSchemasHints: make(map[reflect.Type]string),
+		schemasDocumentNames:  make(map[string]string),
+		typeSchemas:           make(map[reflect.Type]func() *Schema),
+	}
+}
+
+// Register generates the documentation for the app using the registry's Config
+// and serves it on Config.DocsRouter (defaults to the app itself).
+func (r *Registry) Register(app *fiber.App) error {
+	return r.register(app, r.Config)
+}
+
+// GenerateHandlers generates the documentation for the app using the registry's Config,
+// but instead of registering the docs routes, it returns their handlers.
+func (r *Registry) GenerateHandlers(app *fiber.App) (*DocsHandlers, error) {
+	return r.generate(app, r.Config)
+}
+
+func (r *Registry) NewRouter(app *fiber.App) SwaggerRouter {
+	return r.NewRouterFromRouter(app.Group("/"))
+}
+
+func (r *Registry) NewRouterFromRouter(router fiber.Router) SwaggerRouter {
+	return SwaggerRouter{internalGroup: "", Router: router, registry: r}
+}
+
+// CreateSchemaIn works the same way as CreateSchema, but stores the generated components inside the provided registry
+func CreateSchemaIn[T any](registry *Registry) *SchemaRef {
+	var t T
+	registry.schemasMutex.Lock()
+	defer registry.schemasMutex.Unlock()
+	schema := registry.generateSchema(reflect.TypeOf(t), false)
+	if schema != nil && schema.Ref != "" {
+		registry.createdSchemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")] = true
+	}
+	return schema
+}
+
+// generates the schema used by helpers like NewRequestBody[T] inside the DefaultRegistry. Unlike CreateSchema,
+// the generated components are emitted only by the documents referencing them (see adoptReferencedSchemas).
+func createHelperSchema[T any]() *SchemaRef {
+	var t T
+	DefaultRegistry.schemasMutex.Lock()
+	defer DefaultRegistry.schemasMutex.Unlock()
+	return DefaultRegistry.generateSchema(reflect.TypeOf(t), false)
+}
+
+// returns the names of the components created using CreateSchema / CreateSchemaIn, safe for concurrent usage
+func (r *Registry) getCreatedSchemas() []string {
+	r.schemasMutex.Lock()
+	defer r.schemasMutex.Unlock()
+
+	return sortedKeys(r.createdSchemas)
+}
+
+// copies the components referenced by the info, which were generated inside the DefaultRegistry
+// (by helpers like NewRequestBody[T]), into the registry owning the route
+func (r *Registry) adoptReferencedSchemas(info *RouteInfo) {
+	if r == DefaultRegistry || info == nil {
+		return
+	}
+
+	own_schemas := r.getAcquiredSchemas()
+	default_schemas := DefaultRegistry.getAcquiredSchemas()
+	default_types := DefaultRegistry.getAcquiredSchemasTypes()
+	default_hints := DefaultRegistry.getAnonymousSchemasHints()
+	referenced := Schemas{}
+	lookup := func(name string) *SchemaRef {
+		if schema, ok := own_schemas[name]; ok {
+			return schema
+		}
+		return default_schemas[name]
+	}
+	forEachOperationSchema(info, func(schema *SchemaRef) {
+		addReferencedSchema(referenced, schema, lookup)
+	})
+
+	r.schemasMutex.Lock()
+	defer r.schemasMutex.Unlock()
+	for name, schema := range referenced {
+		if r.schemas[name] != nil {
+			continue
+		}
+		r.schemas[name] = schema
+		if t := default_types[name]; t != nil && r.schemasTypes[name] == nil {
+			if _, ok := r.schemasNames[t]; !ok {
+				r.schemasNames[t] = name
+			}
+			r.schemasTypes[name] = t
+			// the usage sites of the anonymous structs name them (see nameSchemaComponents)
+			if hint, ok := default_hints[t]; ok {
+				r.setAnonymousSchemaHint(t, hint)
+			}
+		}
+	}
+}
+
+// adds every component referenced (directly or transitively) by the paths and the components themselves,
+// which is not part of the document yet. The components are looked up inside the registry, then inside the DefaultRegistry
+// (routes registered using RegisterRoute with helpers like NewRequestBody[T]).
+func (r *Registry) addReferencedSchemas(components Schemas, paths *Paths) {
+	own_schemas := r.getAcquiredSchemas()
+	default_schemas := own_schemas
+	if r != DefaultRegistry {
+		default_schemas = DefaultRegistry.getAcquiredSchemas()
+	}
+	lookup := func(name string) *SchemaRef {
+		if schema, ok := own_schemas[name]; ok {
+			return schema
+		}
+		return default_schemas[name]
+	}
+
+	for _, name := range sortedKeys(components) {
+		addReferencedSchema(components, &SchemaRef{Value: components[name].Value}, lookup)
+	}
+	for _, path_item := range paths.Map() {
+		for _, operation := range path_item.Operations() {
+			forEachOperationSchema(operation, func(schema *SchemaRef) {
+				addReferencedSchema(components, schema, lookup)
+			})
+		}
+	}
+}
+
+// calls visit with every schema used directly by the parameters, the request body and the responses of the operation
+func forEachOperationSchema(operation *Operation, visit func(schema *SchemaRef)) {
+	for _, parameter := range operation.Parameters {
+		if parameter != nil && parameter.Value != nil {
+			visit(parameter.Value.Schema)
+		}
+	}
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		for _, media_type := range operation.RequestBody.Value.Content {
+			visit(media_type.Schema)
+		}
+	}
+	if operation.Responses != nil {
+		for _, response := range operation.Responses.Map() {
+			if response == nil || response.Value == nil {
+				continue
+			}
+			for _, media_type := range response.Value.Content {
+				visit(media_type.Schema)
+			}
+		}
+	}
+}
+
+// adds the component referenced by the schema (and the ones referenced by it's properties, items, ...).
+// lookup returns the component of the name, the value of the reference is used when it doesn't know it
+// (it may be a placeholder of a recursive type).
+func addReferencedSchema(components Schemas, schema *SchemaRef, lookup func(name string) *SchemaRef) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != "" {
+		name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		if _, ok := components[name]; ok || name == schema.Ref {
+			return
+		}
+		if component := lookup(name); component != nil && component.Value != nil {
+			schema = &SchemaRef{Value: component.Value}
+		}
+		if schema.Value == nil {
+			return
+		}
+		components[name] = &SchemaRef{Value: schema.Value}
+	}
+	if schema.Value == nil {
+		return
+	}
+
+	for _, property := range schema.Value.Properties {
+		addReferencedSchema(components, property, lookup)
+	}
+	for _, sub_schemas := range []SchemaRefs{schema.Value.OneOf, schema.Value.AnyOf, schema.Value.AllOf} {
+		for _, sub_schema := range sub_schemas {
+			addReferencedSchema(components, sub_schema, lookup)
+		}
+	}
+	addReferencedSchema(components, schema.Value.Items, lookup)
+	addReferencedSchema(components, schema.Value.Not, lookup)
+	addReferencedSchema(components, schema.Value.AdditionalProperties.Schema, lookup)
+}
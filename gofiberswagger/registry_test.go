@@ -0,0 +1,190 @@
+package gofiberswagger
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type RegistryPublicResponse struct {
+	Public string
+}
+
+type RegistryAdminResponse struct {
+	Admin string
+}
+
+func TestRegistry_Isolation(t *testing.T) {
+	t.Parallel()
+
+	// setup
+	public_registry := NewRegistry(&Config{})
+	public_app := fiber.New()
+	public_router := public_registry.NewRouter(public_app)
+	public_router.Get("/public", &RouteInfo{
+		Summary: "public endpoint",
+		Responses: NewResponsesRaw(map[string]*ResponseRef{
+			"200": {Value: openapi3.NewResponse().WithJSONSchemaRef(CreateSchemaIn[RegistryPublicResponse](public_registry))},
+		}),
+	}, func(c fiber.Ctx) error { return c.SendStatus(200) })
+
+	admin_registry := NewRegistry(&Config{})
+	admin_app := fiber.New()
+	admin_router := admin_registry.NewRouter(admin_app)
+	admin_router.Get("/admin", &RouteInfo{
+		Summary: "admin endpoint",
+		Responses: NewResponses(
+			// helpers target the DefaultRegistry, the referenced components should still end up in the admin spec
+			NewResponseInfo[RegistryAdminResponse]("200", "admin response"),
+		),
+	}, func(c fiber.Ctx) error { return c.SendStatus(200) })
+
+	// execute
+	assert.NoError(t, public_registry.Register(public_app))
+	assert.NoError(t, admin_registry.Register(admin_app))
+
+	// verify routes don't leak
+	assert.Nil(t, public_registry.getAcquiredRoutesInfo("GET", "/admin"))
+	assert.Nil(t, admin_registry.getAcquiredRoutesInfo("GET", "/public"))
+	assert.Nil(t, getAcquiredRoutesInfo("GET", "/public"))
+
+	public_paths := public_registry.Config.Swagger.Paths
+	admin_paths := admin_registry.Config.Swagger.Paths
+	assert.NotNil(t, public_paths.Find("/public"))
+	assert.Nil(t, public_paths.Find("/admin"))
+	assert.NotNil(t, admin_paths.Find("/admin"))
+	assert.Nil(t, admin_paths.Find("/public"))
+
+	// verify schemas don't leak
+	public_schemas := public_registry.Config.Swagger.Components.Schemas
+	admin_schemas := admin_registry.Config.Swagger.Components.Schemas
+	assert.Contains(t, public_schemas, "github_com_TDiblik_gofiber-swagger_gofiberswaggerRegistryPublicResponse")
+	assert.NotContains(t, admin_schemas, "github_com_TDiblik_gofiber-swagger_gofiberswaggerRegistryPublicResponse")
+	assert.Contains(t, admin_schemas, "github_com_TDiblik_gofiber-swagger_gofiberswaggerRegistryAdminResponse")
+	assert.NotContains(t, DefaultRegistry.getAcquiredSchemas(), "github_com_TDiblik_gofiber-swagger_gofiberswaggerRegistryPublicResponse")
+
+	// verify both apps serve their own docs
+	resp, err := public_app.Test(httptest.NewRequest("GET", "/swagger/swagger.json", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	resp, err = admin_app.Test(httptest.NewRequest("GET", "/swagger/swagger.json", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+}
+
+type RegistryFirstBody struct {
+	First RegistryFirstNested
+}
+
+type RegistryFirstNested struct {
+	Value string
+}
+
+type RegistrySecondBody struct {
+	Second string
+}
+
+type RegistryExplicitComponent struct {
+	Explicit string
+}
+
+func TestRegistry_DisjointComponents(t *testing.T) {
+	t.Parallel()
+
+	first_registry := NewRegistry(&Config{})
+	first_app := fiber.New()
+	first_registry.NewRouter(first_app).Post("/first", &RouteInfo{
+		RequestBody: NewRequestBodyJSON[RegistryFirstBody](),
+		Responses:   NewResponses(NewResponseInfoNoContent("204", "No Content")),
+	}, func(c fiber.Ctx) error { return c.SendStatus(204) })
+	CreateSchemaIn[RegistryExplicitComponent](first_registry)
+
+	second_registry := NewRegistry(&Config{})
+	second_app := fiber.New()
+	second_registry.NewRouter(second_app).Get("/second", &RouteInfo{
+		Responses: NewResponses(NewResponseInfo[RegistrySecondBody]("200", "OK")),
+	}, func(c fiber.Ctx) error { return c.SendStatus(200) })
+
+	// the helpers store the components inside the DefaultRegistry, which must not emit them
+	default_app := fiber.New()
+	default_app.Get("/default", func(c fiber.Ctx) error { return c.SendStatus(200) })
+	default_config := &Config{}
+
+	first_config := &Config{}
+	_, err := first_registry.generate(first_app, first_config)
+	assert.NoError(t, err)
+	second_config := &Config{}
+	_, err = second_registry.generate(second_app, second_config)
+	assert.NoError(t, err)
+	_, err = DefaultRegistry.generate(default_app, default_config)
+	assert.NoError(t, err)
+
+	name := func(t reflect.Type) string {
+		return "github_com_TDiblik_gofiber-swagger_gofiberswagger" + t.Name()
+	}
+	first_names := sortedKeys(first_config.Swagger.Components.Schemas)
+	second_names := sortedKeys(second_config.Swagger.Components.Schemas)
+	default_names := sortedKeys(default_config.Swagger.Components.Schemas)
+	assert.Equal(t, []string{
+		name(reflect.TypeFor[RegistryExplicitComponent]()),
+		name(reflect.TypeFor[RegistryFirstBody]()),
+		name(reflect.TypeFor[RegistryFirstNested]()),
+	}, first_names)
+	assert.Equal(t, []string{name(reflect.TypeFor[RegistrySecondBody]())}, second_names)
+	for _, component := range append(first_names, second_names...) {
+		assert.NotContains(t, default_names, component)
+	}
+
+	// the components are owned by the registries of the routers
+	assert.Contains(t, first_registry.getAcquiredSchemas(), name(reflect.TypeFor[RegistryFirstNested]()))
+	assert.Contains(t, second_registry.getAcquiredSchemas(), name(reflect.TypeFor[RegistrySecondBody]()))
+}
+
+func TestRegistry_GroupKeepsRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	group := router.Group("/group")
+	group.Get("/endpoint", &RouteInfo{Summary: "Group endpoint"}, func(c fiber.Ctx) error {
+		return c.SendString("ok")
+	})
+
+	assert.NotNil(t, registry.getAcquiredRoutesInfo("GET", "/group/endpoint"))
+	assert.Nil(t, getAcquiredRoutesInfo("GET", "/group/endpoint"))
+}
+
+func TestRegistry_ConcurrentRegister(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	registry.RegisterRoute("GET", "/shared/:id", &RouteInfo{Summary: "shared"})
+
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			app := fiber.New()
+			app.Get("/shared/:id", func(c fiber.Ctx) error { return c.SendStatus(200) })
+			config := &Config{AppendMethodToTags: true}
+			assert.NoError(t, registry.register(app, config))
+
+			operation := config.Swagger.Paths.Find("/shared/{id}").Get
+			assert.Len(t, operation.Parameters, 1)
+			assert.Equal(t, []string{"GET"}, operation.Tags)
+		}()
+	}
+	wg.Wait()
+
+	// the registered info must stay untouched
+	info := registry.getAcquiredRoutesInfo("GET", "/shared/:id")
+	assert.Empty(t, info.Parameters)
+	assert.Empty(t, info.Tags)
+}
@@ -0,0 +1,66 @@
+package gofiberswagger
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Renderer generates the html page displaying the generated spec.
+// Built-in renderers: SwaggerUIConfig, ReDocConfig, ScalarConfig, RapiDocConfig, StoplightElementsConfig
+type Renderer interface {
+	// spec_url is the url of the generated spec (derived from Config.BasePath and Config.YAMLFileName),
+	// renderers should use it unless configured otherwise.
+	Render(spec_url string) ([]byte, error)
+}
+
+func (ui_config SwaggerUIConfig) Render(spec_url string) ([]byte, error) {
+	if ui_config.URL == "" {
+		ui_config.URL = spec_url
+	}
+	return generateIndexPage(swaggerUIConfigDefault(ui_config))
+}
+
+// swagger ui renderers serving embedded assets load them from their own mount path (the assets get served next to the page).
+// Returns the renderer with the AssetsURL filled and the handler serving it's assets (nil for other renderers / the CDN).
+func prepareRendererAssets(renderer Renderer, mount_path string) (Renderer, fiber.Handler, error) {
+	var ui_config SwaggerUIConfig
+	switch renderer := renderer.(type) {
+	case SwaggerUIConfig:
+		ui_config = renderer
+	case *SwaggerUIConfig:
+		if renderer == nil {
+			return renderer, nil, nil
+		}
+		ui_config = *renderer
+	default:
+		return renderer, nil, nil
+	}
+
+	ui_config = swaggerUIConfigDefault(ui_config)
+	if ui_config.AssetsSource != AssetsSourceEmbedded {
+		return ui_config, nil, nil
+	}
+	if err := validateSwaggerUIAssets(ui_config.AssetsFS); err != nil {
+		return nil, nil, err
+	}
+	if ui_config.AssetsURL == "" {
+		ui_config.AssetsURL = mount_path
+	}
+	return ui_config, newSwaggerUIAssetsHandler(ui_config.AssetsFS), nil
+}
+
+func renderTemplate(name string, tmpl string, data any) ([]byte, error) {
+	page_tpl, err := template.New(name).Parse(tmpl)
+	if err != nil {
+		return nil, errors.Join(errors.New("gofiber-swagger: error while parsing the "+name+" template -> "), err)
+	}
+	page_tpl_buf := bytes.NewBufferString("")
+	err = page_tpl.Execute(page_tpl_buf, data)
+	if err != nil {
+		return nil, errors.Join(errors.New("gofiber-swagger: error while executing the "+name+" template -> "), err)
+	}
+	return page_tpl_buf.Bytes(), nil
+}
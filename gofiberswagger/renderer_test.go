@@ -0,0 +1,115 @@
+package gofiberswagger
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		renderer Renderer
+		contains []string
+	}{
+		{"SwaggerUI", SwaggerUIConfig{}, []string{"SwaggerUIBundle", "/docs/swagger.yaml"}},
+		{"ReDoc", ReDocConfig{HideDownloadButton: true}, []string{"<title>ReDoc</title>", "redoc.standalone.js", "Redoc.init", "/docs/swagger.yaml", "hideDownloadButton"}},
+		{"Scalar", ScalarConfig{Theme: "moon"}, []string{"<title>Scalar API Reference</title>", "@scalar/api-reference", "/docs/swagger.yaml", "moon"}},
+		{"RapiDoc", RapiDocConfig{Theme: "dark", HideHeader: true}, []string{"<title>RapiDoc</title>", "<rapi-doc", "spec-url=\"/docs/swagger.yaml\"", "theme=\"dark\"", "show-header=\"false\""}},
+		{"StoplightElements", StoplightElementsConfig{HideTryIt: true}, []string{"<title>Stoplight Elements</title>", "<elements-api", "apiDescriptionUrl=\"/docs/swagger.yaml\"", "hideTryIt=\"true\""}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			page, err := tc.renderer.Render("/docs/swagger.yaml")
+			assert.NoError(t, err)
+			for _, expected := range tc.contains {
+				assert.Contains(t, string(page), expected)
+			}
+		})
+	}
+
+	t.Run("explicit spec url", func(t *testing.T) {
+		t.Parallel()
+		page, err := ReDocConfig{SpecURL: "/other.json"}.Render("/docs/swagger.yaml")
+		assert.NoError(t, err)
+		assert.Contains(t, string(page), "/other.json")
+		assert.NotContains(t, string(page), "/docs/swagger.yaml")
+	})
+}
+
+func TestRegister_Renderers(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	err := Register(app, &Config{
+		BasePath: "/docs",
+		Renderer: ReDocConfig{},
+		AdditionalRenderers: map[string]Renderer{
+			"scalar":   ScalarConfig{},
+			"/rapidoc": RapiDocConfig{},
+			"swagger":  SwaggerUIConfig{},
+		},
+	})
+	assert.NoError(t, err)
+
+	testCases := map[string]string{
+		"/docs":         "Redoc.init",
+		"/docs/scalar":  "@scalar/api-reference",
+		"/docs/rapidoc": "<rapi-doc",
+		"/docs/swagger": "SwaggerUIBundle",
+	}
+	for path, expected := range testCases {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode, path)
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), expected, path)
+		assert.Contains(t, string(body), "/docs/swagger.yaml", path)
+	}
+}
+
+func TestRegister_RenderersEmbeddedAssets(t *testing.T) {
+	t.Parallel()
+
+	app := fiber.New()
+	err := Register(app, &Config{
+		BasePath: "/docs",
+		Renderer: &SwaggerUIConfig{AssetsSource: AssetsSourceEmbedded, AssetsFS: newTestSwaggerUIAssets()},
+		AdditionalRenderers: map[string]Renderer{
+			"/ui/": SwaggerUIConfig{AssetsSource: AssetsSourceEmbedded, AssetsFS: newTestSwaggerUIAssets()},
+			"cdn":  SwaggerUIConfig{},
+		},
+	})
+	assert.NoError(t, err)
+
+	testCases := map[string]string{
+		"/docs":     "/docs/swagger-ui.css",
+		"/docs/ui":  "/docs/ui/swagger-ui.css",
+		"/docs/cdn": "https://unpkg.com/swagger-ui-dist@" + DefaultUIConfig.Version + "/swagger-ui.css",
+	}
+	for path, expected := range testCases {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+		assert.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), expected, path)
+	}
+
+	// the assets are served next to the pages using them
+	for _, path := range []string{"/docs/swagger-ui-bundle.js", "/docs/ui/swagger-ui-bundle.js", "/docs/ui/favicon-16x16.png"} {
+		resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode, path)
+	}
+	resp, err := app.Test(httptest.NewRequest("GET", "/docs/cdn/swagger-ui-bundle.js", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 404, resp.StatusCode)
+}
@@ -2,39 +2,79 @@ package gofiberswagger
 
 import (
 	"strings"
-	"sync"
 
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
 type RouteInfo = openapi3.Operation
 
-var (
-	acquiredRoutesInfo map[string]*RouteInfo
-	mutex              = &sync.Mutex{}
-)
-
+// RegisterRoute registers the route info inside the DefaultRegistry
 func RegisterRoute(method string, path string, info *RouteInfo) {
-	mutex.Lock()
-	defer mutex.Unlock()
+	DefaultRegistry.RegisterRoute(method, path, info)
+}
+
+func (r *Registry) RegisterRoute(method string, path string, info *RouteInfo) {
+	r.adoptReferencedSchemas(info)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
 
-	if acquiredRoutesInfo == nil {
-		acquiredRoutesInfo = make(map[string]*RouteInfo)
-	}
 	if info == nil {
 		info = &RouteInfo{}
 	}
-	acquiredRoutesInfo[getAcquiredRoutesInfoId(method, path)] = info
+	r.routesInfo[getAcquiredRoutesInfoId(method, path)] = info
+}
+
+func (r *Registry) setRouteHandler(method string, path string, handler any) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.routesHandlers[getAcquiredRoutesInfoId(method, path)] = handler
+}
+
+func (r *Registry) getRouteHandler(method string, path string) any {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.routesHandlers[getAcquiredRoutesInfoId(method, path)]
 }
 
 func getAcquiredRoutesInfo(method string, path string) *RouteInfo {
-	mutex.Lock()
-	defer mutex.Unlock()
+	return DefaultRegistry.getAcquiredRoutesInfo(method, path)
+}
+
+// returns the docs of the route with the current docs of it's groups applied (nil for unknown routes)
+func (r *Registry) getAcquiredRoutesInfo(method string, path string) *RouteInfo {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	id := getAcquiredRoutesInfoId(method, path)
+	info := r.routesInfo[id]
+	if info == nil || len(r.routesGroups[id]) == 0 {
+		return info
+	}
+	return applyGroupsInfo(info, r.routesGroups[id])
+}
 
-	if acquiredRoutesInfo == nil {
-		return nil
+// creates a shallow copy of the info, which can be modified without affecting the original.
+// Slices & pointers modified by Register (parameters, tags, security, responses) are copied as well.
+func copyRouteInfo(info *RouteInfo) *RouteInfo {
+	if info == nil {
+		return &RouteInfo{}
+	}
+
+	info_copy := *info
+	if info.Parameters != nil {
+		info_copy.Parameters = append(Parameters{}, info.Parameters...)
+	}
+	if info.Tags != nil {
+		info_copy.Tags = append([]string{}, info.Tags...)
+	}
+	if info.Security != nil {
+		security := append(SecurityRequirements{}, *info.Security...)
+		info_copy.Security = &security
 	}
-	return acquiredRoutesInfo[getAcquiredRoutesInfoId(method, path)]
+	return &info_copy
 }
 
 func getAcquiredRoutesInfoId(method string, path string) string {
@@ -7,14 +7,19 @@ import (
 type SwaggerRouter struct {
 	internalGroup string
 	Router        fiber.Router
+	registry      *Registry
+	// groups the router is nested in, the outermost first
+	groups []*routerGroup
 }
 
+// NewRouter creates a router which registers it's routes inside the DefaultRegistry
 func NewRouter(app *fiber.App) SwaggerRouter {
-	return NewRouterFromRouter(app.Group("/"))
+	return DefaultRegistry.NewRouter(app)
 }
 
+// NewRouterFromRouter creates a router which registers it's routes inside the DefaultRegistry
 func NewRouterFromRouter(r fiber.Router) SwaggerRouter {
-	return SwaggerRouter{internalGroup: "", Router: r}
+	return DefaultRegistry.NewRouterFromRouter(r)
 }
 
 func (router SwaggerRouter) Use(args any) fiber.Router {
@@ -22,51 +27,102 @@ func (router SwaggerRouter) Use(args any) fiber.Router {
 }
 
 func (router SwaggerRouter) Get(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("GET", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("GET", path, docs, handler)
 	return router.Router.Get(path, handler, handlers...)
 }
 func (router SwaggerRouter) Head(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("HEAD", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("HEAD", path, docs, handler)
 	return router.Router.Head(path, handler, handlers...)
 }
 func (router SwaggerRouter) Post(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("POST", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("POST", path, docs, handler)
 	return router.Router.Post(path, handler, handlers...)
 }
 func (router SwaggerRouter) Put(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("PUT", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("PUT", path, docs, handler)
 	return router.Router.Put(path, handler, handlers...)
 }
 func (router SwaggerRouter) Delete(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("DELETE", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("DELETE", path, docs, handler)
 	return router.Router.Delete(path, handler, handlers...)
 }
 func (router SwaggerRouter) Connect(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("CONNECT", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("CONNECT", path, docs, handler)
 	return router.Router.Connect(path, handler, handlers...)
 }
 func (router SwaggerRouter) Options(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("OPTIONS", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("OPTIONS", path, docs, handler)
 	return router.Router.Options(path, handler, handlers...)
 }
 func (router SwaggerRouter) Trace(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("TRACE", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("TRACE", path, docs, handler)
 	return router.Router.Trace(path, handler, handlers...)
 }
 func (router SwaggerRouter) Patch(path string, docs *RouteInfo, handler any, handlers ...any) fiber.Router {
-	routerRegisterRouteInternal("PATCH", path, router.internalGroup, docs)
+	handler = router.registerRouteInternal("PATCH", path, docs, handler)
 	return router.Router.Patch(path, handler, handlers...)
 }
+
+// Group creates a nested router. Pass *GroupInfo between the handlers to document the routes of the group
+// (and it's nested groups), groups without a tag get tagged by their prefix.
 func (router *SwaggerRouter) Group(prefix string, handlers ...any) SwaggerRouter {
-	return SwaggerRouter{internalGroup: router.internalGroup + prefix, Router: router.Router.Group(prefix, handlers...)}
+	info, handlers := extractGroupInfo(handlers)
+	internal_group := router.internalGroup + prefix
+
+	var parent *routerGroup
+	if len(router.groups) > 0 {
+		parent = router.groups[len(router.groups)-1]
+	}
+	group := newRouterGroup(parent, internal_group)
+	registry := router.getRegistry()
+	registry.registerGroup(group)
+	groups := append(append([]*routerGroup{}, router.groups...), group)
+	nested := SwaggerRouter{internalGroup: internal_group, Router: router.Router.Group(prefix, handlers...), registry: router.registry, groups: groups}
+	return nested.Info(info)
+}
+
+// Info documents the routes of the group (see GroupInfo), replacing the docs passed to Group.
+// The docs of the groups are applied when the document gets generated, so the routes registered
+// before the call are documented as well. Routers which aren't groups are returned unchanged.
+func (router SwaggerRouter) Info(info *GroupInfo) SwaggerRouter {
+	if len(router.groups) == 0 {
+		return router
+	}
+	registry := router.getRegistry()
+	registry.setGroupInfo(router.groups[len(router.groups)-1], info)
+	if info != nil {
+		registry.adoptReferencedSchemas(info.template())
+	}
+	return router
+}
+
+func (router SwaggerRouter) getRegistry() *Registry {
+	if router.registry == nil {
+		return DefaultRegistry
+	}
+	return router.registry
 }
 
-func routerRegisterRouteInternal(method string, path string, internalGroup string, info *RouteInfo) {
+// registers the route info and returns the handler which should be passed to fiber (typed handlers get unwrapped)
+func (router SwaggerRouter) registerRouteInternal(method string, path string, info *RouteInfo, handler any) any {
+	registry := router.getRegistry()
+	_, is_typed := handler.(TypedHandler)
+	if info == nil && !is_typed {
+		// keep the docs registered beforehand using RegisterRoute (e.g. generated by cmd/routegen)
+		if registered := registry.getAcquiredRoutesInfo(method, router.internalGroup+path); registered != nil {
+			registry.setRouteGroups(method, router.internalGroup+path, router.groups)
+			return handler
+		}
+	}
 	if info == nil {
 		info = &RouteInfo{}
 	}
-	//if internalGroup != "" {
-	//	info.Tags = append(info.Tags, internalGroup)
-	//}
-	RegisterRoute(method, internalGroup+path, info)
+	if typed, ok := handler.(TypedHandler); ok {
+		info = typed.routeInfoIn(registry, info)
+		registry.setRouteHandler(method, router.internalGroup+path, typed.handlerFunc())
+		handler = typed.Handler()
+	}
+	registry.RegisterRoute(method, router.internalGroup+path, info)
+	registry.setRouteGroups(method, router.internalGroup+path, router.groups)
+	return handler
 }
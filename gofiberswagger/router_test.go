@@ -126,3 +126,22 @@ func TestSwaggerRouter_Group(t *testing.T) {
 	assert.Equal(t, "Group endpoint", registeredDocs.Summary)
 	assert.Contains(t, registeredDocs.Tags, "/test")
 }
+
+func TestSwaggerRouter_KeepsRegisteredDocs(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	registry.RegisterRoute("GET", "/api/generated", &RouteInfo{Summary: "generated"})
+	registry.RegisterRoute("GET", "/api/overwritten", &RouteInfo{Summary: "generated"})
+
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	group := router.Group("/api")
+	group.Get("/generated", nil, func(c fiber.Ctx) error { return nil })
+	group.Get("/overwritten", &RouteInfo{Summary: "explicit"}, func(c fiber.Ctx) error { return nil })
+	group.Get("/undocumented", nil, func(c fiber.Ctx) error { return nil })
+
+	assert.Equal(t, "generated", registry.getAcquiredRoutesInfo("GET", "/api/generated").Summary)
+	assert.Equal(t, "explicit", registry.getAcquiredRoutesInfo("GET", "/api/overwritten").Summary)
+	assert.NotNil(t, registry.getAcquiredRoutesInfo("GET", "/api/undocumented"))
+}
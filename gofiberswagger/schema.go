@@ -1,58 +1,84 @@
 package gofiberswagger
 
 import (
-	"math/rand/v2"
 	"reflect"
-	"strconv"
 	"strings"
-
-	"github.com/google/uuid"
 )
 
-var acquiredSchemas map[string]*SchemaRef
-
-func setToAcquiredSchemas(ref string, schema *SchemaRef) {
-	if acquiredSchemas == nil {
-		acquiredSchemas = make(map[string]*SchemaRef)
-	}
+// call only while holding r.schemasMutex!
+func (r *Registry) setToAcquiredSchemas(ref string, schema *SchemaRef) {
 	if schema != nil {
-		acquiredSchemas[ref] = schema
+		r.schemas[ref] = schema
 	}
 }
-func getFromAcquiredSchemas(ref string) *SchemaRef {
-	if acquiredSchemas == nil {
-		return nil
+
+// call only while holding r.schemasMutex!
+func (r *Registry) getFromAcquiredSchemas(ref string) *SchemaRef {
+	return r.schemas[ref]
+}
+
+// returns a copy of the types of the acquired schemas, safe for concurrent usage
+func (r *Registry) getAcquiredSchemasTypes() map[string]reflect.Type {
+	r.schemasMutex.Lock()
+	defer r.schemasMutex.Unlock()
+
+	types := make(map[string]reflect.Type, len(r.schemasTypes))
+	for k, v := range r.schemasTypes {
+		types[k] = v
+	}
+	return types
+}
+
+// returns a copy of the usage sites of the anonymous structs, safe for concurrent usage
+func (r *Registry) getAnonymousSchemasHints() map[reflect.Type]string {
+	r.schemasMutex.Lock()
+	defer r.schemasMutex.Unlock()
+
+	hints := make(map[reflect.Type]string, len(r.anonymousSchemasHints))
+	for k, v := range r.anonymousSchemasHints {
+		hints[k] = v
 	}
+	return hints
+}
+
+// returns a copy of the acquired schemas, safe for concurrent usage
+func (r *Registry) getAcquiredSchemas() map[string]*SchemaRef {
+	r.schemasMutex.Lock()
+	defer r.schemasMutex.Unlock()
 
-	return acquiredSchemas[ref]
+	schemas := make(map[string]*SchemaRef, len(r.schemas))
+	for k, v := range r.schemas {
+		schemas[k] = v
+	}
+	return schemas
 }
 
+// CreateSchema generates the schema for T and stores the generated components inside the DefaultRegistry
 func CreateSchema[T any]() *SchemaRef {
-	var t T
-	return generateSchema(reflect.TypeOf(t), false)
+	return CreateSchemaIn[T](DefaultRegistry)
 }
 
-func generateSchema(t reflect.Type, stopRecursion bool) *SchemaRef {
+// call only while holding r.schemasMutex!
+func (r *Registry) generateSchema(t reflect.Type, stopRecursion bool) *SchemaRef {
 	for t.Kind() == reflect.Pointer {
 		t = t.Elem()
 	}
 
-	tName := t.Name()
-	if tName == "" {
-		var genPartOfName string
-		if genPart, err := uuid.NewUUID(); err == nil {
-			genPartOfName = genPart.String()
-		} else {
-			genPartOfName = strconv.Itoa(rand.Int())
+	// registered / custom / special types (time, uuid, sql.Null*, ...) are handled the same way as struct fields
+	if custom, nullable := r.getCustomTypeSchema(t); custom != nil {
+		if nullable {
+			custom.Value.Nullable = true
 		}
-		tName = "generated-" + genPartOfName
+		return custom
 	}
 
-	ref := strings.ReplaceAll(strings.ReplaceAll(t.PkgPath(), "/", "_"), ".", "_") + tName
-	ref_path := "#/components/schemas/" + ref
-	possibleSchema := getFromAcquiredSchemas(ref)
-	if possibleSchema != nil {
-		if t.Kind() == reflect.Struct {
+	// only structs become components
+	var ref, ref_path string
+	if t.Kind() == reflect.Struct && t.NumField() > 0 {
+		ref = r.schemaName(t)
+		ref_path = "#/components/schemas/" + ref
+		possibleSchema := r.getFromAcquiredSchemas(ref)
+		if possibleSchema != nil {
 			return &SchemaRef{
 				Ref:        ref_path,
 				Extensions: possibleSchema.Extensions,
@@ -60,7 +86,6 @@ func generateSchema(t reflect.Type, stopRecursion bool) *SchemaRef {
 				Value:      possibleSchema.Value,
 			}
 		}
-		return possibleSchema
 	}
 
 	schema := getDefaultSchema(t)
@@ -79,16 +104,19 @@ func generateSchema(t reflect.Type, stopRecursion bool) *SchemaRef {
 		enumSchema := &SchemaRef{
 			Value: getDefaultSchema(t),
 		}
-		handleEnumValues(enumSchema, options, false, t)
+		r.handleEnumValues(enumSchema, options, false, t)
 		return enumSchema
 	}
 
 	if t.Kind() == reflect.Struct {
-		schema.Title = tName
+		schema.Title = SanitizedTypeName(t)
+		if schema.Title == "" {
+			schema.Title = ref
+		}
 		schema.Type = &Types{"object"}
 
 		// set placeholder that will get overwritten to prevent recursion
-		setToAcquiredSchemas(ref, &SchemaRef{Value: &Schema{}})
+		r.setToAcquiredSchemas(ref, &SchemaRef{Value: &Schema{}})
 
 		for i := range t.NumField() {
 			field := t.Field(i)
@@ -100,7 +128,7 @@ func generateSchema(t reflect.Type, stopRecursion bool) *SchemaRef {
 				}
 
 				if fieldType.Kind() == reflect.Struct {
-					subSchema := generateSchema(fieldType, false)
+					subSchema := r.generateSchema(fieldType, false)
 					if subSchema != nil && subSchema.Value != nil {
 						for name, prop := range subSchema.Value.Properties {
 							if _, ok := schema.Properties[name]; !ok {
@@ -112,7 +140,7 @@ func generateSchema(t reflect.Type, stopRecursion bool) *SchemaRef {
 				}
 			}
 
-			jsonTag, jsonTagExists := field.Tag.Lookup("json")
+			jsonTag := field.Tag.Get("json")
 			if jsonTag == "-" {
 				continue
 			}
@@ -122,291 +150,19 @@ func generateSchema(t reflect.Type, stopRecursion bool) *SchemaRef {
 				continue
 			}
 
-			isNullable := false
-			fieldType := field.Type
-			for fieldType.Kind() == reflect.Pointer {
-				fieldType = fieldType.Elem()
-				isNullable = true
-			}
-			fieldTypeName := fieldType.Name()
-			fieldTypePkgPath := fieldType.PkgPath()
-			fieldKind := fieldType.Kind()
-
-			// for debugging purposes:
-			// log.Println(field)
-
-			// create schema for the field. First handle special cases!
-			var result *SchemaRef = nil
-			switch {
-			// skip channels and functions
-			case fieldKind == reflect.Func, fieldKind == reflect.Chan:
+			r.setAnonymousSchemaHint(field.Type, schema.Title+field.Name)
+			fieldName, result, required := r.generateFieldSchema(field)
+			if result == nil {
 				continue
-
-			// handle time.Time type
-			case fieldKind == reflect.Struct && fieldType == timeType:
-				result = &SchemaRef{Value: &Schema{
-					Type:   &Types{"string"},
-					Format: "date-time",
-				}}
-
-			// handle file uploads
-			case fieldKind == reflect.Struct && fieldTypeName == "FileHeader" && fieldTypePkgPath == "mime/multipart":
-				result = &SchemaRef{Value: &Schema{
-					Type:   &Types{"string"},
-					Format: "binary",
-				}}
-
-			// handle uuid.UUID
-			case fieldKind == reflect.Array && fieldTypeName == "UUID" && fieldType.Elem().Kind() == reflect.Uint8:
-				result = &SchemaRef{Value: &Schema{
-					Type:   &Types{"string"},
-					Format: "uuid",
-				}}
-
-			// handle uuid.NullUUID and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullUUID", "UUID") || isNullTypeWrapper(fieldType, "NullUUID", "UUID")):
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type:   &Types{"string"},
-					Format: "uuid",
-				}}
-
-			// handle sql.NullBool and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullBool", "Bool") || isNullTypeWrapper(fieldType, "NullBool", "Bool")):
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type: &Types{"boolean"},
-				}}
-
-			// handle sql.NullByte and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullByte", "Byte") || isNullTypeWrapper(fieldType, "NullByte", "Byte")):
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type:   &Types{"string"},
-					Format: "byte",
-				}}
-
-			// handle sql.NullInt16 and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullInt16", "Int16") || isNullTypeWrapper(fieldType, "NullInt16", "Int16")):
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type:         &Types{"integer"},
-					Min:          &minInt16,
-					Max:          &maxInt16,
-					ExclusiveMin: false,
-					ExclusiveMax: false,
-				}}
-
-			// handle sql.NullInt32 and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullInt32", "Int32") || isNullTypeWrapper(fieldType, "NullInt32", "Int32")):
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type:         &Types{"integer"},
-					Format:       "int32",
-					Min:          &minInt32,
-					Max:          &maxInt32,
-					ExclusiveMin: false,
-					ExclusiveMax: false,
-				}}
-
-			// handle sql.NullInt64 and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullInt64", "Int64") || isNullTypeWrapper(fieldType, "NullInt64", "Int64")):
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type:         &Types{"integer"},
-					Format:       "int64",
-					Min:          &minInt64,
-					Max:          &maxInt64,
-					ExclusiveMin: false,
-					ExclusiveMax: false,
-				}}
-
-			// handle sql.NullFloat64 and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullFloat64", "Float64") || isNullTypeWrapper(fieldType, "NullFloat64", "Float64")):
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type:         &Types{"number"},
-					Format:       "double",
-					Min:          &minInt64,
-					Max:          &maxInt64,
-					ExclusiveMin: false,
-					ExclusiveMax: false,
-				}}
-
-			// handle sql.NullTime and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullTime", "Time") || isNullTypeWrapper(fieldType, "NullTime", "Time")): // todo: we could also check whether the Time field is of time.Time type
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type:   &Types{"string"},
-					Format: "date-time",
-				}}
-
-			// handle sql.NullString and it's alias wrappers
-			case fieldKind == reflect.Struct && (isNullType(fieldType, "NullString", "String") || isNullTypeWrapper(fieldType, "NullString", "String")):
-				isNullable = true
-				result = &SchemaRef{Value: &Schema{
-					Type: &Types{"string"},
-				}}
-
-			// handle bytes
-			case fieldKind == reflect.Slice && fieldType.Elem().Kind() == reflect.Uint8:
-				if fieldType == rawMessageType {
-					result = &SchemaRef{Value: &Schema{}}
-				} else {
-					result = &SchemaRef{Value: &Schema{
-						Type:   &Types{"string"},
-						Format: "byte",
-					}}
-				}
-
-			// handle map[string]object
-			case fieldKind == reflect.Map && fieldType.Key().Kind() == reflect.String:
-				valueSchema := generateSchema(fieldType.Elem(), false)
-				has := true
-				result = &SchemaRef{Value: &Schema{
-					Type: &Types{"object"},
-					AdditionalProperties: AdditionalProperties{
-						Has:    &has,
-						Schema: valueSchema,
-					},
-				}}
-
-			// handle general structs
-			case fieldKind == reflect.Struct:
-				result = generateSchema(fieldType, false)
-
-			// handle general slices / arrays
-			case fieldKind == reflect.Slice, fieldKind == reflect.Array:
-				result = &SchemaRef{Value: &Schema{
-					Type:  &Types{"array"},
-					Items: generateSchema(fieldType.Elem(), false),
-				}}
-
-			// handle general maps / interface{} / any
-			case fieldKind == reflect.Map || fieldKind == reflect.Interface:
-				result = &SchemaRef{Value: &Schema{
-					Type: &Types{"object"},
-				}}
-
-			// generated default schema for non-special types (string/int/etc)
-			default:
-				result = &SchemaRef{
-					Value: getDefaultSchema(fieldType),
-				}
-			}
-			result.Value.Nullable = isNullable
-
-			// handle json tag
-			fieldName := field.Name
-			jsonTagOptions := strings.Split(jsonTag, ",")
-			if jsonTagExists && len(jsonTagOptions) > 0 && jsonTagOptions[0] != "" {
-				fieldName = jsonTagOptions[0]
-			}
-			for i := 1; i < len(jsonTagOptions); i++ {
-				option := jsonTagOptions[i]
-				switch option {
-				case "string":
-					result.Value.Type = &Types{"string"}
-				case "omitempty":
-					result.Value.Nullable = true
-					result.Value.Description += " omitempty "
-				case "omitzero":
-					result.Value.Nullable = true
-					result.Value.Description += " omitzero "
-				}
-			}
-
-			// handle xml tag
-			xmlTagOptions := strings.Split(xmlTag, ",")
-			if xmlTagExists && len(xmlTagOptions) > 0 && result.Value.XML == nil {
-				result.Value.XML = &XML{}
-			}
-			if xmlTagExists && len(xmlTagOptions) > 0 && xmlTagOptions[0] != "" {
-				result.Value.XML.Name = xmlTagOptions[0]
 			}
-			for i := 1; i < len(xmlTagOptions); i++ {
-				option := xmlTagOptions[i]
-				switch option {
-				case "attr":
-					result.Value.XML.Attribute = true
-				case "chardata", "cdata", "innerxml", "comment":
-					result.Value.Description += " " + option + " "
-				case "omitempty":
-					result.Value.Nullable = true
-					result.Value.Description += " omitempty "
-				}
-				// todo: handle `name>first` / `a>b>c` syntax
-			}
-
-			// handle enum values
-			if implementsSwaggerEnum(fieldType) {
-				handleEnumValues(result, getSwaggerEnumValues(fieldType), false, fieldType)
-			}
-
-			// handle validate tag
-			validateTag := field.Tag.Get("validate")
-			validateTagOptions := strings.Split(validateTag, ",")
-			for _, validation := range validateTagOptions {
-				switch {
-				case validation == "required":
-					schema.Required = append(schema.Required, fieldName)
-					result.Value.Nullable = false
-					result.Value.AllowEmptyValue = false
-				case strings.HasPrefix(validation, "min=") && (fieldKind == reflect.Slice || fieldKind == reflect.Array):
-					if minValue, err := strconv.ParseUint(strings.TrimPrefix(validation, "min="), 10, 64); err == nil {
-						result.Value.MinItems = minValue
-					}
-				case strings.HasPrefix(validation, "min=") && fieldKind == reflect.String:
-					if minValue, err := strconv.ParseUint(strings.TrimPrefix(validation, "min="), 10, 64); err == nil {
-						result.Value.MinLength = minValue
-					}
-				case strings.HasPrefix(validation, "min="):
-					if minValue, err := strconv.ParseFloat(strings.TrimPrefix(validation, "min="), 64); err == nil {
-						result.Value.Min = &minValue
-						result.Value.Default = minValue
-					}
-				case strings.HasPrefix(validation, "max=") && (fieldKind == reflect.Slice || fieldKind == reflect.Array):
-					if maxValue, err := strconv.ParseUint(strings.TrimPrefix(validation, "max="), 10, 64); err == nil {
-						result.Value.MaxItems = &maxValue
-					}
-				case strings.HasPrefix(validation, "max=") && fieldKind == reflect.String:
-					if maxValue, err := strconv.ParseUint(strings.TrimPrefix(validation, "max="), 10, 64); err == nil {
-						result.Value.MaxLength = &maxValue
-					}
-				case strings.HasPrefix(validation, "max="):
-					if maxValue, err := strconv.ParseFloat(strings.TrimPrefix(validation, "max="), 64); err == nil {
-						result.Value.Max = &maxValue
-					}
-				case strings.HasPrefix(validation, "minLength="):
-					if minLen, err := strconv.ParseUint(strings.TrimPrefix(validation, "minLength="), 10, 64); err == nil {
-						result.Value.MinLength = minLen
-					}
-				case strings.HasPrefix(validation, "maxLength="):
-					if maxLen, err := strconv.ParseUint(strings.TrimPrefix(validation, "maxLength="), 10, 64); err == nil {
-						result.Value.MaxLength = &maxLen
-					}
-				case strings.HasPrefix(validation, "uniqueItems"):
-					result.Value.UniqueItems = true
-				case strings.HasPrefix(validation, "omitnil"):
-					result.Value.Description += " omitnil "
-				case strings.HasPrefix(validation, "oneof="):
-					// oneof is more important than all other options since that's what the validator is using...
-					// in that case, ignore and overwrite every other enum / OneOf options
-					options := []any{}
-					stringOptions := strings.Split(strings.TrimPrefix(validation, "oneof="), " ")
-					for _, option := range stringOptions {
-						options = append(options, option)
-					}
-					handleEnumValues(result, options, true, fieldType)
-				}
+			if required {
+				schema.Required = append(schema.Required, fieldName)
 			}
-			result.Value.Title = fieldName
-			result.Value.Description = strings.ReplaceAll(result.Value.Description, "  ", "")
-
 			schema.Properties[fieldName] = result
 		}
 
-		setToAcquiredSchemas(ref, &SchemaRef{
+		applySwaggerFieldSchema(t, schema)
+		r.setToAcquiredSchemas(ref, &SchemaRef{
 			Value: schema,
 		})
 		return &SchemaRef{
@@ -415,11 +171,288 @@ func generateSchema(t reflect.Type, stopRecursion bool) *SchemaRef {
 		}
 	}
 
+	// slices / arrays (items) and maps (additionalProperties), top level interface{} / any stays unconstrained
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if result, _ := r.generateTypeSchema(t); result != nil {
+			return result
+		}
+	}
+
 	return &SchemaRef{
 		Value: schema,
 	}
 }
 
+// generates the schema of a single struct field (json/xml/validate tags, enums, special types).
+// Returns nil schema for fields which can't be represented (channels, functions).
+// call only while holding r.schemasMutex!
+func (r *Registry) generateFieldSchema(field reflect.StructField) (fieldName string, result *SchemaRef, required bool) {
+	jsonTag, jsonTagExists := field.Tag.Lookup("json")
+	xmlTag, xmlTagExists := field.Tag.Lookup("xml")
+
+	isNullable := false
+	fieldType := field.Type
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+		isNullable = true
+	}
+
+	// for debugging purposes:
+	// log.Println(field)
+
+	// create schema for the field
+	result, isNullType := r.generateTypeSchema(fieldType)
+	if result == nil {
+		return field.Name, nil, false
+	}
+	isNullable = isNullable || isNullType
+
+	// referenced schemas share their value with the component, make sure we don't overwrite it with field specific info
+	if result.Ref != "" && result.Value != nil {
+		value := *result.Value
+		result = &SchemaRef{Ref: result.Ref, Extensions: result.Extensions, Origin: result.Origin, Value: &value}
+	}
+	result.Value.Nullable = isNullable
+
+	// handle json tag
+	fieldName = field.Name
+	jsonTagOptions := strings.Split(jsonTag, ",")
+	if jsonTagExists && len(jsonTagOptions) > 0 && jsonTagOptions[0] != "" {
+		fieldName = jsonTagOptions[0]
+	}
+	for i := 1; i < len(jsonTagOptions); i++ {
+		option := jsonTagOptions[i]
+		switch option {
+		case "string":
+			result.Value.Type = &Types{"string"}
+		case "omitempty", "omitzero":
+			result.Value.Nullable = true
+		}
+	}
+
+	// handle xml tag
+	xmlTagOptions := strings.Split(xmlTag, ",")
+	if xmlTagExists && len(xmlTagOptions) > 0 && result.Value.XML == nil {
+		result.Value.XML = &XML{}
+	}
+	if xmlTagExists && len(xmlTagOptions) > 0 && xmlTagOptions[0] != "" {
+		result.Value.XML.Name = xmlTagOptions[0]
+	}
+	for i := 1; i < len(xmlTagOptions); i++ {
+		option := xmlTagOptions[i]
+		switch option {
+		case "attr":
+			result.Value.XML.Attribute = true
+		case "omitempty":
+			result.Value.Nullable = true
+		}
+		// todo: handle `name>first` / `a>b>c` syntax
+	}
+
+	// handle enum values
+	if implementsSwaggerEnum(fieldType) {
+		r.handleEnumValues(result, getSwaggerEnumValues(fieldType), false, fieldType)
+	}
+
+	// handle validate tag
+	required, validationNotes := r.applyValidateTag(field.Tag.Get("validate"), fieldType, result)
+	result.Value.Title = fieldName
+
+	// handle documentation tags (doc, example, format, ...)
+	r.applyDocumentationTags(field, result.Value)
+	appendDescription(result.Value, validationNotes...)
+	if result.Ref == "" {
+		applySwaggerFieldSchema(fieldType, result.Value)
+	}
+
+	return fieldName, result, required
+}
+
+// returns the schema of the types which are handled specially (time, uuid, file uploads, sql.Null*, bytes),
+// or nil for any other type. nullable is set for the sql.Null* types.
+func getSpecialTypeSchema(t reflect.Type) (result *SchemaRef, nullable bool) {
+	typeName := t.Name()
+	typePkgPath := t.PkgPath()
+	kind := t.Kind()
+
+	switch {
+	// handle time.Time type
+	case kind == reflect.Struct && t == timeType:
+		result = &SchemaRef{Value: &Schema{
+			Type:   &Types{"string"},
+			Format: "date-time",
+		}}
+
+	// handle file uploads
+	case kind == reflect.Struct && typeName == "FileHeader" && typePkgPath == "mime/multipart":
+		result = &SchemaRef{Value: &Schema{
+			Type:   &Types{"string"},
+			Format: "binary",
+		}}
+
+	// handle uuid.UUID
+	case kind == reflect.Array && typeName == "UUID" && t.Elem().Kind() == reflect.Uint8:
+		result = &SchemaRef{Value: &Schema{
+			Type:   &Types{"string"},
+			Format: "uuid",
+		}}
+
+	// handle uuid.NullUUID and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullUUID", "UUID") || isNullTypeWrapper(t, "NullUUID", "UUID")):
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type:   &Types{"string"},
+			Format: "uuid",
+		}}
+
+	// handle sql.NullBool and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullBool", "Bool") || isNullTypeWrapper(t, "NullBool", "Bool")):
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type: &Types{"boolean"},
+		}}
+
+	// handle sql.NullByte and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullByte", "Byte") || isNullTypeWrapper(t, "NullByte", "Byte")):
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type:   &Types{"string"},
+			Format: "byte",
+		}}
+
+	// handle sql.NullInt16 and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullInt16", "Int16") || isNullTypeWrapper(t, "NullInt16", "Int16")):
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type:         &Types{"integer"},
+			Min:          &minInt16,
+			Max:          &maxInt16,
+			ExclusiveMin: false,
+			ExclusiveMax: false,
+		}}
+
+	// handle sql.NullInt32 and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullInt32", "Int32") || isNullTypeWrapper(t, "NullInt32", "Int32")):
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type:         &Types{"integer"},
+			Format:       "int32",
+			Min:          &minInt32,
+			Max:          &maxInt32,
+			ExclusiveMin: false,
+			ExclusiveMax: false,
+		}}
+
+	// handle sql.NullInt64 and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullInt64", "Int64") || isNullTypeWrapper(t, "NullInt64", "Int64")):
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type:         &Types{"integer"},
+			Format:       "int64",
+			Min:          &minInt64,
+			Max:          &maxInt64,
+			ExclusiveMin: false,
+			ExclusiveMax: false,
+		}}
+
+	// handle sql.NullFloat64 and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullFloat64", "Float64") || isNullTypeWrapper(t, "NullFloat64", "Float64")):
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type:         &Types{"number"},
+			Format:       "double",
+			Min:          &minInt64,
+			Max:          &maxInt64,
+			ExclusiveMin: false,
+			ExclusiveMax: false,
+		}}
+
+	// handle sql.NullTime and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullTime", "Time") || isNullTypeWrapper(t, "NullTime", "Time")): // todo: we could also check whether the Time field is of time.Time type
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type:   &Types{"string"},
+			Format: "date-time",
+		}}
+
+	// handle sql.NullString and it's alias wrappers
+	case kind == reflect.Struct && (isNullType(t, "NullString", "String") || isNullTypeWrapper(t, "NullString", "String")):
+		nullable = true
+		result = &SchemaRef{Value: &Schema{
+			Type: &Types{"string"},
+		}}
+
+	// handle bytes
+	case kind == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		if t == rawMessageType {
+			result = &SchemaRef{Value: &Schema{}}
+		} else {
+			result = &SchemaRef{Value: &Schema{
+				Type:   &Types{"string"},
+				Format: "byte",
+			}}
+		}
+
+	}
+	return result, nullable
+}
+
+// generates the schema of any type (special types, structs, slices / arrays, maps, primitives).
+// Returns nil schema for types which can't be represented (channels, functions).
+// call only while holding r.schemasMutex!
+func (r *Registry) generateTypeSchema(t reflect.Type) (result *SchemaRef, nullable bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if result, nullable := r.getCustomTypeSchema(t); result != nil {
+		return result, nullable
+	}
+
+	kind := t.Kind()
+	switch {
+	// handle map[string]object
+	case kind == reflect.Map && t.Key().Kind() == reflect.String:
+		valueSchema := r.generateSchema(t.Elem(), false)
+		has := true
+		result = &SchemaRef{Value: &Schema{
+			Type: &Types{"object"},
+			AdditionalProperties: AdditionalProperties{
+				Has:    &has,
+				Schema: valueSchema,
+			},
+		}}
+
+	// skip channels and functions
+	case kind == reflect.Func, kind == reflect.Chan:
+		return nil, false
+
+	// handle general structs
+	case kind == reflect.Struct:
+		result = r.generateSchema(t, false)
+
+	// handle general slices / arrays
+	case kind == reflect.Slice, kind == reflect.Array:
+		result = &SchemaRef{Value: &Schema{
+			Type:  &Types{"array"},
+			Items: r.generateSchema(t.Elem(), false),
+		}}
+
+	// handle general maps / interface{} / any
+	case kind == reflect.Map || kind == reflect.Interface:
+		result = &SchemaRef{Value: &Schema{
+			Type: &Types{"object"},
+		}}
+
+	// generated default schema for non-special types (string/int/etc)
+	default:
+		result = &SchemaRef{
+			Value: getDefaultSchema(t),
+		}
+	}
+	return result, false
+}
+
 func getDefaultSchema(t reflect.Type) *Schema {
 	schema := Schema{
 		Properties: make(Schemas),
@@ -573,7 +606,7 @@ func isNullTypeWrapper(fieldType reflect.Type, nullFieldName string, uniqueField
 }
 
 // modifies the `result *SchemaRef`
-func handleEnumValues(result *SchemaRef, options []any, overwrite bool, fieldType reflect.Type) {
+func (r *Registry) handleEnumValues(result *SchemaRef, options []any, overwrite bool, fieldType reflect.Type) {
 	if result.Value.OneOf == nil || overwrite {
 		result.Value.OneOf = []*SchemaRef{}
 	}
@@ -581,7 +614,7 @@ func handleEnumValues(result *SchemaRef, options []any, overwrite bool, fieldTyp
 		result.Value.Enum = []any{}
 	}
 	for _, option := range options {
-		option_schema := generateSchema(fieldType, true)
+		option_schema := r.generateSchema(fieldType, true)
 		option_schema.Value.Default = option
 		result.Value.OneOf = append(result.Value.OneOf, option_schema)
 		result.Value.Enum = append(result.Value.Enum, option)
@@ -0,0 +1,85 @@
+package gofiberswagger
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+)
+
+// ISwaggerSchema lets a type supply it's own schema, instead of the one generated by reflection.
+// Useful for types with custom MarshalJSON / MarshalText methods.
+//
+//	func (Money) SwaggerSchema() *gofiberswagger.Schema {
+//		return &gofiberswagger.Schema{Type: &gofiberswagger.Types{"string"}, Pattern: `^\d+\.\d{2}$`}
+//	}
+type ISwaggerSchema interface {
+	SwaggerSchema() *Schema
+}
+
+// ISwaggerFieldSchema lets a type modify the schema generated for the struct fields (and parameters) of it's type,
+// after the tags got applied. Struct types stored as components get their component schema modified instead.
+//
+//	func (Email) SwaggerFieldSchema(schema *gofiberswagger.Schema) {
+//		schema.Format = "email"
+//	}
+type ISwaggerFieldSchema interface {
+	SwaggerFieldSchema(schema *Schema)
+}
+
+var (
+	swaggerSchemaInterface      = reflect.TypeOf((*ISwaggerSchema)(nil)).Elem()
+	swaggerFieldSchemaInterface = reflect.TypeOf((*ISwaggerFieldSchema)(nil)).Elem()
+	textMarshalerInterface      = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonMarshalerInterface      = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// reports whether t or *t implements the interface
+func implementsInterface(t reflect.Type, iface reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	kind := t.Kind()
+	return t.Implements(iface) || (kind != reflect.Pointer && kind != reflect.UnsafePointer && kind != reflect.Invalid && reflect.PointerTo(t).Implements(iface))
+}
+
+// returns a zero value of t (or a pointer to it, for pointer receivers) as the interface.
+// call implementsInterface beforehand!
+func newInterfaceInstance(t reflect.Type, iface reflect.Type) any {
+	if t.Implements(iface) {
+		return reflect.New(t).Elem().Interface()
+	}
+	return reflect.New(t).Interface()
+}
+
+// returns the schema of the types which are not reflected: registered (RegisterTypeSchema & built-in ones),
+// ISwaggerSchema implementations, special types (time, uuid, sql.Null*, ...) and text marshalers (strings),
+// which are not json marshalers.
+// Returns nil for any other type, nullable is set for the sql.Null* types.
+// call only while holding r.schemasMutex!
+func (r *Registry) getCustomTypeSchema(t reflect.Type) (result *SchemaRef, nullable bool) {
+	if registered := r.getRegisteredTypeSchema(t); registered != nil {
+		return registered, false
+	}
+	if implementsInterface(t, swaggerSchemaInterface) {
+		if schema := newInterfaceInstance(t, swaggerSchemaInterface).(ISwaggerSchema).SwaggerSchema(); schema != nil {
+			return &SchemaRef{Value: schema}, false
+		}
+	}
+	if special, nullable := getSpecialTypeSchema(t); special != nil {
+		return special, nullable
+	}
+	// encoding/json serializes text marshalers as strings, unless they marshal themselves into json
+	// (which may produce anything, describe them using ISwaggerSchema / RegisterTypeSchema)
+	if implementsInterface(t, textMarshalerInterface) && !implementsInterface(t, jsonMarshalerInterface) && !implementsSwaggerEnum(t) {
+		return &SchemaRef{Value: &Schema{Type: &Types{"string"}}}, false
+	}
+	return nil, false
+}
+
+// lets the type modify the schema (ISwaggerFieldSchema)
+func applySwaggerFieldSchema(t reflect.Type, schema *Schema) {
+	if schema == nil || !implementsInterface(t, swaggerFieldSchemaInterface) {
+		return
+	}
+	newInterfaceInstance(t, swaggerFieldSchemaInterface).(ISwaggerFieldSchema).SwaggerFieldSchema(schema)
+}
@@ -0,0 +1,138 @@
+package gofiberswagger
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type CustomMoney struct {
+	units int64
+	cents int64
+}
+
+func (CustomMoney) SwaggerSchema() *Schema {
+	return &Schema{Type: &Types{"string"}, Pattern: `^\d+\.\d{2}$`}
+}
+
+type CustomEmail string
+
+func (CustomEmail) SwaggerFieldSchema(schema *Schema) {
+	schema.Format = "email"
+}
+
+type CustomLevel int
+
+func (l CustomLevel) MarshalText() ([]byte, error) {
+	return []byte("level"), nil
+}
+
+type CustomToken struct {
+	value string
+}
+
+func (t *CustomToken) MarshalText() ([]byte, error) {
+	return []byte(t.value), nil
+}
+
+// marshals itself into a json object, the text form is used by query parameters
+type CustomCoordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+func (c CustomCoordinates) MarshalText() ([]byte, error) {
+	return []byte("lat,lng"), nil
+}
+
+func (c CustomCoordinates) MarshalJSON() ([]byte, error) {
+	return []byte(`{"lat":0,"lng":0}`), nil
+}
+
+type CustomVersion struct {
+	Major int `json:"major"`
+}
+
+func (v *CustomVersion) MarshalText() ([]byte, error) {
+	return []byte("v1"), nil
+}
+
+func (v *CustomVersion) MarshalJSON() ([]byte, error) {
+	return []byte(`{"major":1}`), nil
+}
+
+type CustomAddress struct {
+	Street string `json:"street"`
+}
+
+func (*CustomAddress) SwaggerFieldSchema(schema *Schema) {
+	schema.Description = "postal address"
+}
+
+type CustomSchemas struct {
+	Price    CustomMoney    `json:"price"`
+	Prices   []CustomMoney  `json:"prices"`
+	Email    CustomEmail    `json:"email" validate:"required"`
+	Level    CustomLevel    `json:"level"`
+	Token    *CustomToken   `json:"token"`
+	Address  CustomAddress  `json:"address"`
+	Previous *CustomAddress `json:"previous"`
+}
+
+type CustomJSONMarshalers struct {
+	Coordinates CustomCoordinates `json:"coordinates"`
+	Version     *CustomVersion    `json:"version"`
+}
+
+func TestSchema_CustomSchemas(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	schema := CreateSchemaIn[CustomSchemas](registry)
+	properties := schema.Value.Properties
+
+	// ISwaggerSchema
+	assert.Equal(t, `^\d+\.\d{2}$`, properties["price"].Value.Pattern)
+	assert.Equal(t, "string", (*properties["price"].Value.Type)[0])
+	assert.Equal(t, `^\d+\.\d{2}$`, properties["prices"].Value.Items.Value.Pattern)
+	assert.Equal(t, `^\d+\.\d{2}$`, CreateSchemaIn[CustomMoney](registry).Value.Pattern)
+
+	// ISwaggerFieldSchema
+	assert.Equal(t, "email", properties["email"].Value.Format)
+	assert.Contains(t, schema.Value.Required, "email")
+
+	// text marshalers are strings
+	assert.Equal(t, "string", (*properties["level"].Value.Type)[0])
+	assert.Equal(t, "string", (*properties["token"].Value.Type)[0])
+	assert.True(t, properties["token"].Value.Nullable)
+
+	// components get modified once
+	components := registry.getAcquiredSchemas()
+	assert.Len(t, components, 2)
+	address := components[SchemaNamingFullPath(reflect.TypeOf(CustomAddress{}))]
+	assert.Equal(t, "postal address", address.Value.Description)
+	assert.Equal(t, properties["address"].Ref, properties["previous"].Ref)
+}
+
+func TestSchema_JSONMarshalersAreNotStrings(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	properties := CreateSchemaIn[CustomJSONMarshalers](registry).Value.Properties
+
+	// json marshalers are left to the reflection, even when they are text marshalers as well
+	coordinates := registry.getAcquiredSchemas()[SchemaNamingFullPath(reflect.TypeOf(CustomCoordinates{}))]
+	assert.NotEmpty(t, properties["coordinates"].Ref)
+	assert.Equal(t, "object", (*coordinates.Value.Type)[0])
+	assert.Contains(t, coordinates.Value.Properties, "lat")
+	version := registry.getAcquiredSchemas()[SchemaNamingFullPath(reflect.TypeOf(CustomVersion{}))]
+	assert.NotEmpty(t, properties["version"].Ref)
+	assert.Contains(t, version.Value.Properties, "major")
+
+	// custom schemas are still used
+	RegisterTypeSchemaIn[CustomCoordinates](registry, func() *Schema {
+		return &Schema{Type: &Types{"string"}, Pattern: `^-?\d+(\.\d+)?,-?\d+(\.\d+)?$`}
+	})
+	assert.Equal(t, "string", (*CreateSchemaIn[CustomCoordinates](registry).Value.Type)[0])
+}
@@ -0,0 +1,555 @@
+package gofiberswagger
+
+import (
+	"cmp"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Names of the generated component schemas                                     ///
+/// ---------------------------------------------------------------------------- ///
+
+// SchemaNamingStrategy returns the component name of the schema generated for the named struct type t.
+// The returned name gets sanitized. Collisions between different types get resolved when the document gets generated,
+// every one of the colliding types falls back to SchemaNamingPackage, SchemaNamingFullPath and finally a numeric suffix
+// (see resolveSchemaNames), so that the names don't depend on the order in which the schemas got generated.
+type SchemaNamingStrategy func(t reflect.Type) string
+
+// SchemaNamingFullPath names the schemas by their full package path and type name (`github_com_x_modelsUser`).
+// It's the default strategy, since the names are unique.
+func SchemaNamingFullPath(t reflect.Type) string {
+	return strings.ReplaceAll(strings.ReplaceAll(t.PkgPath(), "/", "_"), ".", "_") + SanitizedTypeName(t)
+}
+
+// SchemaNamingPackage names the schemas by their package name and type name (`models.User`)
+func SchemaNamingPackage(t reflect.Type) string {
+	pkg_path := t.PkgPath()
+	return pkg_path[strings.LastIndex(pkg_path, "/")+1:] + "." + SanitizedTypeName(t)
+}
+
+// SchemaNamingShort names the schemas just by their type name (`User`, `PageOfUser`)
+func SchemaNamingShort(t reflect.Type) string {
+	return SanitizedTypeName(t)
+}
+
+// SanitizedTypeName returns the name of the type with the type arguments of generic types spelled out
+// (`Page[github.com/x/models.User]` -> `PageOfUser`, `Pair[int,[]string]` -> `PairOfIntAndStringList`)
+func SanitizedTypeName(t reflect.Type) string {
+	return sanitizeTypeName(t.Name())
+}
+
+func sanitizeTypeName(name string) string {
+	name = strings.TrimSpace(strings.TrimLeft(name, "*"))
+	switch {
+	case name == "":
+		return ""
+	case strings.HasPrefix(name, "[]"):
+		return sanitizeTypeName(name[2:]) + "List"
+	case strings.HasPrefix(name, "map["):
+		key_end := matchingBracket(name, 3)
+		if key_end == -1 {
+			break
+		}
+		return "MapOf" + sanitizeTypeName(name[4:key_end]) + "To" + sanitizeTypeName(name[key_end+1:])
+	case strings.HasPrefix(name, "["):
+		if length_end := strings.Index(name, "]"); length_end != -1 {
+			return sanitizeTypeName(name[length_end+1:]) + "List"
+		}
+	case strings.HasPrefix(name, "struct"):
+		return "Struct"
+	case strings.HasPrefix(name, "interface"), name == "any":
+		return "Any"
+	case strings.HasPrefix(name, "func"):
+		return "Func"
+	case strings.HasPrefix(name, "chan"), strings.HasPrefix(name, "<-chan"):
+		return "Chan"
+	}
+
+	base, args := name, []string{}
+	if open := strings.Index(name, "["); open != -1 && matchingBracket(name, open) == len(name)-1 {
+		base = name[:open]
+		for _, arg := range splitTypeArguments(name[open+1 : len(name)-1]) {
+			args = append(args, sanitizeTypeName(arg))
+		}
+	}
+	// `github.com/x/models.User` -> `User`
+	base = base[strings.LastIndex(base, "/")+1:]
+	base = base[strings.LastIndex(base, ".")+1:]
+	if len(args) > 0 {
+		base += "Of" + strings.Join(args, "And")
+	}
+
+	first, size := utf8.DecodeRuneInString(base)
+	return string(unicode.ToUpper(first)) + base[size:]
+}
+
+// index of the bracket closing the one at open, or -1
+func matchingBracket(name string, open int) int {
+	depth := 0
+	for i := open; i < len(name); i++ {
+		switch name[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splits `int,map[string]int,Pair[a,b]` on the top level commas
+func splitTypeArguments(args string) []string {
+	result := []string{}
+	depth, start := 0, 0
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case '[', '(', '{':
+			depth++
+		case ']', ')', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, args[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(result, args[start:])
+}
+
+var invalidSchemaNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// component names must match ^[a-zA-Z0-9\.\-_]+$
+func sanitizeSchemaName(name string) string {
+	return strings.Trim(invalidSchemaNameChars.ReplaceAllString(name, "_"), "_")
+}
+
+// remembers where an anonymous struct is used (`ParentField`), so that it can be named after it.
+// Structs used by multiple fields are named after the first of them in alphabetical order.
+// call only while holding r.schemasMutex!
+func (r *Registry) setAnonymousSchemaHint(t reflect.Type, hint string) {
+	for {
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+			continue
+		}
+		break
+	}
+	if t.Kind() != reflect.Struct || t.Name() != "" {
+		return
+	}
+	if existing, ok := r.anonymousSchemasHints[t]; !ok || hint < existing {
+		r.anonymousSchemasHints[t] = hint
+	}
+}
+
+// returns the sanitized names of the struct type t, in the order of preference.
+// call only while holding r.schemasMutex!
+func (r *Registry) schemaNameCandidates(t reflect.Type) []string {
+	names := []string{}
+	if t.Name() == "" {
+		// anonymous structs are named after their usage site, or their structure
+		if hint, ok := r.anonymousSchemasHints[t]; ok {
+			names = append(names, hint)
+		}
+		hash := fnv.New32a()
+		hash.Write([]byte(t.String()))
+		names = append(names, fmt.Sprintf("AnonymousStruct%08x", hash.Sum32()))
+	} else {
+		strategy := SchemaNamingFullPath
+		if r.Config != nil && r.Config.SchemaNaming != nil {
+			strategy = r.Config.SchemaNaming
+		}
+		names = append(names, strategy(t), SchemaNamingPackage(t), SchemaNamingFullPath(t))
+	}
+
+	candidates := []string{}
+	for _, name := range names {
+		if name = sanitizeSchemaName(name); name != "" && !slices.Contains(candidates, name) {
+			candidates = append(candidates, name)
+		}
+	}
+	return candidates
+}
+
+// returns the provisional component name of the struct type t, which stays the same for the whole lifetime of the registry.
+// The name is unique within the registry, the name used by the documents gets resolved when they get generated
+// (see nameSchemaComponents). call only while holding r.schemasMutex!
+func (r *Registry) schemaName(t reflect.Type) string {
+	if name, ok := r.schemasNames[t]; ok {
+		return name
+	}
+
+	candidates := r.schemaNameCandidates(t)
+	name := ""
+	for _, candidate := range candidates {
+		if r.schemasTypes[candidate] == nil {
+			name = candidate
+			break
+		}
+	}
+	if name == "" {
+		base := "Schema"
+		if len(candidates) > 0 {
+			base = candidates[0]
+		}
+		for i := 2; name == "" || r.schemasTypes[name] != nil; i++ {
+			name = base + strconv.Itoa(i)
+		}
+	}
+
+	r.schemasNames[t] = name
+	r.schemasTypes[name] = t
+	return name
+}
+
+/// ---------------------------------------------------------------------------- ///
+/// Names of the components inside the generated documents                       ///
+/// ---------------------------------------------------------------------------- ///
+
+// names of a type, used to resolve the collisions between the types of a document
+type schemaNaming struct {
+	t          reflect.Type
+	candidates []string
+	// orders the types sharing all of their candidates, which get numbered
+	key string
+}
+
+// returns the naming of every type of the registry (by their provisional names)
+// and the names given to them by the previously generated documents (document name -> provisional name)
+func (r *Registry) getSchemasNamings() (map[string]schemaNaming, map[string]string) {
+	r.schemasMutex.Lock()
+	defer r.schemasMutex.Unlock()
+
+	namings := make(map[string]schemaNaming, len(r.schemasTypes))
+	for name, t := range r.schemasTypes {
+		namings[name] = schemaNaming{t: t, candidates: r.schemaNameCandidates(t), key: t.PkgPath() + " " + t.String()}
+	}
+	document_names := make(map[string]string, len(r.schemasDocumentNames))
+	for name, provisional := range r.schemasDocumentNames {
+		document_names[name] = provisional
+	}
+	return namings, document_names
+}
+
+// gives the component schemas of the document names, which don't depend on the order in which their types got generated
+// (see resolveSchemaNames), and updates the references to them. The schemas, operations, ... shared with the registries
+// and the routes infos get copied, not modified.
+func (r *Registry) nameSchemaComponents(document *SwaggerConfig) {
+	if document.Components == nil || len(document.Components.Schemas) == 0 {
+		return
+	}
+	own_namings, document_names := r.getSchemasNamings()
+	default_namings := own_namings
+	if r != DefaultRegistry {
+		default_namings, _ = DefaultRegistry.getSchemasNamings()
+	}
+
+	components := Schemas{}
+	namings := map[string]schemaNaming{}
+	reserved := map[string]bool{}
+	for _, name := range sortedKeys(document.Components.Schemas) {
+		schema := document.Components.Schemas[name]
+		// components named by a previous generation of the document (when it's config gets reused)
+		if provisional, ok := document_names[name]; ok {
+			if _, ok := document.Components.Schemas[provisional]; ok {
+				continue
+			}
+			name = provisional
+		}
+		components[name] = schema
+		if naming, ok := own_namings[name]; ok {
+			namings[name] = naming
+		} else if naming, ok := default_namings[name]; ok {
+			namings[name] = naming
+		} else {
+			// the user's own components keep their names
+			reserved[name] = true
+		}
+	}
+
+	// a type may have different provisional names inside the registry and the DefaultRegistry,
+	// it's named once (by the first of them in alphabetical order)
+	named_types := map[reflect.Type]string{}
+	duplicates := map[string]string{}
+	for _, name := range sortedKeys(namings) {
+		if first, ok := named_types[namings[name].t]; ok {
+			duplicates[name] = first
+			delete(namings, name)
+			continue
+		}
+		named_types[namings[name].t] = name
+	}
+
+	names := resolveSchemaNames(namings, reserved)
+	renamed := map[string]string{}
+	for name, document_name := range names {
+		if name != document_name {
+			renamed[name] = document_name
+		}
+	}
+	for name, first := range duplicates {
+		renamed[name] = names[first]
+	}
+
+	r.schemasMutex.Lock()
+	for name, document_name := range renamed {
+		r.schemasDocumentNames[document_name] = name
+	}
+	r.schemasMutex.Unlock()
+
+	schemas := make(Schemas, len(components))
+	for name, schema := range components {
+		document_name, ok := renamed[name]
+		if !ok {
+			schemas[name] = renameSchemaRefs(schema, renamed)
+			continue
+		}
+		if _, ok := duplicates[name]; ok {
+			continue
+		}
+		schema = renameSchemaRefs(schema, renamed)
+		// anonymous structs are titled by their names
+		if schema != nil && schema.Value != nil && schema.Value.Title == name {
+			value := *schema.Value
+			value.Title = document_name
+			schema = &SchemaRef{Ref: schema.Ref, Extensions: schema.Extensions, Origin: schema.Origin, Value: &value}
+		}
+		schemas[document_name] = schema
+	}
+	document.Components.Schemas = schemas
+	if len(renamed) == 0 {
+		return
+	}
+
+	for _, path_item := range document.Paths.Map() {
+		for _, operation := range path_item.Operations() {
+			renameOperationRefs(operation, renamed)
+		}
+	}
+	for name, parameter := range document.Components.Parameters {
+		document.Components.Parameters[name] = renameParameterRefs(parameter, renamed)
+	}
+	for name, request_body := range document.Components.RequestBodies {
+		document.Components.RequestBodies[name] = renameRequestBodyRefs(request_body, renamed)
+	}
+	for name, response := range document.Components.Responses {
+		document.Components.Responses[name] = renameResponseRefs(response, renamed)
+	}
+	document.Components.Headers = renameHeadersRefs(document.Components.Headers, renamed)
+}
+
+// returns the names of the types (by their provisional names), which depend only on the types themselves,
+// not on the order in which they got generated. Every type whose candidate is shared by another type (or reserved)
+// falls back to it's next candidate, the types sharing all of their candidates get numbered in the order of their keys.
+func resolveSchemaNames(namings map[string]schemaNaming, reserved map[string]bool) map[string]string {
+	names := make(map[string]string, len(namings))
+	taken := map[string]bool{}
+	for name := range reserved {
+		taken[name] = true
+	}
+
+	pending := sortedKeys(namings)
+	for level := 0; len(pending) > 0; level++ {
+		users := map[string]int{}
+		for _, name := range pending {
+			if candidates := namings[name].candidates; level < len(candidates) {
+				users[candidates[level]]++
+			}
+		}
+		if len(users) == 0 {
+			break
+		}
+
+		remaining := []string{}
+		for _, name := range pending {
+			candidates := namings[name].candidates
+			if level < len(candidates) && users[candidates[level]] == 1 && !taken[candidates[level]] {
+				names[name] = candidates[level]
+				taken[candidates[level]] = true
+				continue
+			}
+			remaining = append(remaining, name)
+		}
+		pending = remaining
+	}
+
+	slices.SortFunc(pending, func(a string, b string) int {
+		return cmp.Or(strings.Compare(namings[a].key, namings[b].key), strings.Compare(a, b))
+	})
+	for _, name := range pending {
+		base := "Schema"
+		if candidates := namings[name].candidates; len(candidates) > 0 {
+			base = candidates[0]
+		}
+		for i := 2; ; i++ {
+			if candidate := base + strconv.Itoa(i); !taken[candidate] {
+				names[name] = candidate
+				taken[candidate] = true
+				break
+			}
+		}
+	}
+	return names
+}
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+// returns the schema with the references to the renamed components (old name -> new name) updated,
+// the schema gets copied only when it changes
+func renameSchemaRefs(schema *SchemaRef, renamed map[string]string) *SchemaRef {
+	if schema == nil {
+		return nil
+	}
+	if schema.Ref != "" {
+		name, ok := renamed[strings.TrimPrefix(schema.Ref, componentsSchemasPrefix)]
+		if !ok || !strings.HasPrefix(schema.Ref, componentsSchemasPrefix) {
+			return schema
+		}
+		// the value is the referenced component, renamed on it's own
+		return &SchemaRef{Ref: componentsSchemasPrefix + name, Extensions: schema.Extensions, Origin: schema.Origin, Value: schema.Value}
+	}
+	if schema.Value == nil {
+		return schema
+	}
+
+	value := *schema.Value
+	changed := false
+	rename := func(sub_schema *SchemaRef) *SchemaRef {
+		renamed_schema := renameSchemaRefs(sub_schema, renamed)
+		changed = changed || renamed_schema != sub_schema
+		return renamed_schema
+	}
+	if len(value.Properties) > 0 {
+		value.Properties = make(Schemas, len(schema.Value.Properties))
+		for name, property := range schema.Value.Properties {
+			value.Properties[name] = rename(property)
+		}
+	}
+	for _, sub_schemas := range []*SchemaRefs{&value.OneOf, &value.AnyOf, &value.AllOf} {
+		if len(*sub_schemas) > 0 {
+			renamed_sub_schemas := make(SchemaRefs, len(*sub_schemas))
+			for i, sub_schema := range *sub_schemas {
+				renamed_sub_schemas[i] = rename(sub_schema)
+			}
+			*sub_schemas = renamed_sub_schemas
+		}
+	}
+	value.Items = rename(value.Items)
+	value.Not = rename(value.Not)
+	value.AdditionalProperties.Schema = rename(value.AdditionalProperties.Schema)
+	if value.Discriminator != nil && len(value.Discriminator.Mapping) > 0 {
+		discriminator := *value.Discriminator
+		discriminator.Mapping = make(openapi3.StringMap[openapi3.MappingRef], len(value.Discriminator.Mapping))
+		for key, mapping := range value.Discriminator.Mapping {
+			if name, ok := renamed[strings.TrimPrefix(mapping.Ref, componentsSchemasPrefix)]; ok && strings.HasPrefix(mapping.Ref, componentsSchemasPrefix) {
+				mapping.Ref = componentsSchemasPrefix + name
+				changed = true
+			}
+			discriminator.Mapping[key] = mapping
+		}
+		value.Discriminator = &discriminator
+	}
+
+	if !changed {
+		return schema
+	}
+	return &SchemaRef{Extensions: schema.Extensions, Origin: schema.Origin, Value: &value}
+}
+
+// replaces the parameters, request body and responses of the operation by their renamed copies (see renameSchemaRefs)
+func renameOperationRefs(operation *Operation, renamed map[string]string) {
+	if len(operation.Parameters) > 0 {
+		parameters := make(Parameters, len(operation.Parameters))
+		for i, parameter := range operation.Parameters {
+			parameters[i] = renameParameterRefs(parameter, renamed)
+		}
+		operation.Parameters = parameters
+	}
+	operation.RequestBody = renameRequestBodyRefs(operation.RequestBody, renamed)
+	if operation.Responses != nil && operation.Responses.Len() > 0 {
+		responses := &Responses{Extensions: operation.Responses.Extensions}
+		for code, response := range operation.Responses.Map() {
+			responses.Set(code, renameResponseRefs(response, renamed))
+		}
+		operation.Responses = responses
+	}
+}
+
+func renameParameterRefs(parameter *ParameterRef, renamed map[string]string) *ParameterRef {
+	if parameter == nil || parameter.Value == nil {
+		return parameter
+	}
+	value := *parameter.Value
+	value.Schema = renameSchemaRefs(value.Schema, renamed)
+	value.Content = renameContentRefs(value.Content, renamed)
+	return &ParameterRef{Ref: parameter.Ref, Extensions: parameter.Extensions, Origin: parameter.Origin, Value: &value}
+}
+
+func renameRequestBodyRefs(request_body *RequestBodyRef, renamed map[string]string) *RequestBodyRef {
+	if request_body == nil || request_body.Value == nil {
+		return request_body
+	}
+	value := *request_body.Value
+	value.Content = renameContentRefs(value.Content, renamed)
+	return &RequestBodyRef{Ref: request_body.Ref, Extensions: request_body.Extensions, Origin: request_body.Origin, Value: &value}
+}
+
+func renameResponseRefs(response *ResponseRef, renamed map[string]string) *ResponseRef {
+	if response == nil || response.Value == nil {
+		return response
+	}
+	value := *response.Value
+	value.Content = renameContentRefs(value.Content, renamed)
+	value.Headers = renameHeadersRefs(value.Headers, renamed)
+	return &ResponseRef{Ref: response.Ref, Extensions: response.Extensions, Origin: response.Origin, Value: &value}
+}
+
+func renameHeadersRefs(headers Headers, renamed map[string]string) Headers {
+	if len(headers) == 0 {
+		return headers
+	}
+	renamed_headers := make(Headers, len(headers))
+	for name, header := range headers {
+		if header == nil || header.Value == nil {
+			renamed_headers[name] = header
+			continue
+		}
+		value := *header.Value
+		value.Schema = renameSchemaRefs(value.Schema, renamed)
+		value.Content = renameContentRefs(value.Content, renamed)
+		renamed_headers[name] = &HeaderRef{Ref: header.Ref, Extensions: header.Extensions, Origin: header.Origin, Value: &value}
+	}
+	return renamed_headers
+}
+
+func renameContentRefs(content Content, renamed map[string]string) Content {
+	if len(content) == 0 {
+		return content
+	}
+	renamed_content := make(Content, len(content))
+	for media_type_name, media_type := range content {
+		if media_type == nil {
+			renamed_content[media_type_name] = media_type
+			continue
+		}
+		media_type_copy := *media_type
+		media_type_copy.Schema = renameSchemaRefs(media_type.Schema, renamed)
+		renamed_content[media_type_name] = &media_type_copy
+	}
+	return renamed_content
+}
@@ -0,0 +1,205 @@
+package gofiberswagger
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type NamingUser struct {
+	Name    string `json:"name"`
+	Address struct {
+		Street string `json:"street"`
+	} `json:"address"`
+}
+
+type NamingPage[T any] struct {
+	Items []T `json:"items"`
+	Total int `json:"total"`
+}
+
+type NamingPair[K comparable, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+type NamingOther struct {
+	ID int `json:"id"`
+}
+
+type NamingAddress = struct {
+	Street string `json:"street"`
+}
+
+type NamingHome struct {
+	Address NamingAddress `json:"address"`
+}
+
+type NamingWork struct {
+	Address *NamingAddress `json:"address"`
+}
+
+func TestSanitizeTypeName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{"User", "User"},
+		{"user", "User"},
+		{"Page[github.com/x/models.User]", "PageOfUser"},
+		{"Page[*github.com/x/models.User]", "PageOfUser"},
+		{"Pair[int,[]string]", "PairOfIntAndStringList"},
+		{"Pair[string,map[string]github.com/x/models.User]", "PairOfStringAndMapOfStringToUser"},
+		{"Page[main.Box[gopkg.in/yaml.v3.Node]]", "PageOfBoxOfNode"},
+		{"Page[[4]uint8]", "PageOfUint8List"},
+		{"Page[interface {}]", "PageOfAny"},
+		{"Page[struct { A int }]", "PageOfStruct"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, sanitizeTypeName(tc.name), tc.name)
+	}
+}
+
+func TestSchemaNaming(t *testing.T) {
+	t.Parallel()
+
+	user_type := reflect.TypeOf(NamingUser{})
+	assert.Equal(t, "github_com_TDiblik_gofiber-swagger_gofiberswaggerNamingUser", SchemaNamingFullPath(user_type))
+	assert.Equal(t, "gofiberswagger.NamingUser", SchemaNamingPackage(user_type))
+	assert.Equal(t, "NamingUser", SchemaNamingShort(user_type))
+	assert.Equal(t, "NamingPageOfNamingUser", SchemaNamingShort(reflect.TypeOf(NamingPage[NamingUser]{})))
+	assert.Equal(t, "github_com_TDiblik_gofiber-swagger_gofiberswaggerNamingPageOfNamingUser", SchemaNamingFullPath(reflect.TypeOf(NamingPage[NamingUser]{})))
+}
+
+func TestRegistry_SchemaNames(t *testing.T) {
+	t.Parallel()
+
+	generate := func(naming SchemaNamingStrategy) map[string]*SchemaRef {
+		registry := NewRegistry(&Config{SchemaNaming: naming})
+		CreateSchemaIn[NamingPage[NamingUser]](registry)
+		CreateSchemaIn[NamingPair[string, NamingOther]](registry)
+		CreateSchemaIn[struct {
+			Count int `json:"count"`
+		}](registry)
+		return registry.getAcquiredSchemas()
+	}
+
+	t.Run("short", func(t *testing.T) {
+		schemas := generate(SchemaNamingShort)
+		anonymous := 0
+		for name := range schemas {
+			if strings.HasPrefix(name, "AnonymousStruct") {
+				anonymous++
+			}
+		}
+		assert.Equal(t, 1, anonymous)
+		assert.Len(t, schemas, 6)
+		for _, name := range []string{"NamingPageOfNamingUser", "NamingUser", "NamingUserAddress", "NamingPairOfStringAndNamingOther", "NamingOther"} {
+			assert.Contains(t, schemas, name)
+		}
+
+		page := schemas["NamingPageOfNamingUser"]
+		assert.Equal(t, "NamingPageOfNamingUser", page.Value.Title)
+		assert.Equal(t, "#/components/schemas/NamingUser", page.Value.Properties["items"].Value.Items.Ref)
+		assert.Equal(t, "#/components/schemas/NamingUserAddress", schemas["NamingUser"].Value.Properties["address"].Ref)
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		first := generate(SchemaNamingShort)
+		second := generate(SchemaNamingShort)
+		for name := range first {
+			assert.Contains(t, second, name)
+		}
+	})
+
+	t.Run("collisions", func(t *testing.T) {
+		same := func(t reflect.Type) string { return "Same" }
+		generate := func(create ...func(registry *Registry)) Schemas {
+			registry := NewRegistry(&Config{SchemaNaming: same})
+			for _, create := range create {
+				create(registry)
+			}
+			config := &Config{}
+			_, err := registry.generate(fiber.New(), config)
+			assert.NoError(t, err)
+			return config.Swagger.Components.Schemas
+		}
+		user := func(registry *Registry) { CreateSchemaIn[NamingUser](registry) }
+		other := func(registry *Registry) { CreateSchemaIn[NamingOther](registry) }
+		pair := func(registry *Registry) { CreateSchemaIn[NamingPair[string, NamingOther]](registry) }
+
+		// every colliding type gets qualified, whatever the order of their registration
+		first := generate(user, other, pair)
+		second := generate(pair, other, user)
+		assert.ElementsMatch(t, sortedKeys(first), sortedKeys(second))
+		assert.NotContains(t, first, "Same")
+		assert.Contains(t, first, "gofiberswagger.NamingUser")
+		assert.Contains(t, first, "gofiberswagger.NamingOther")
+		assert.Contains(t, first, "gofiberswagger.NamingPairOfStringAndNamingOther")
+		for _, schemas := range []Schemas{first, second} {
+			assert.Equal(t, "NamingUser", schemas["gofiberswagger.NamingUser"].Value.Title)
+			assert.Equal(t, "#/components/schemas/gofiberswagger.NamingOther", schemas["gofiberswagger.NamingPairOfStringAndNamingOther"].Value.Properties["value"].Ref)
+		}
+
+		// the type without collision keeps the name given by the strategy
+		assert.Contains(t, generate(user), "Same")
+	})
+
+	t.Run("collisions of routes", func(t *testing.T) {
+		registry := NewRegistry(&Config{SchemaNaming: func(t reflect.Type) string { return "Same" }})
+		app := fiber.New()
+		info := &RouteInfo{Responses: NewResponses(NewResponseInfo[NamingUser]("200", "OK"))}
+		registry.NewRouter(app).Get("/users", info, func(c fiber.Ctx) error { return nil })
+		CreateSchemaIn[NamingOther](registry)
+		CreateSchemaIn[NamingUser](registry)
+
+		config := &Config{}
+		_, err := registry.generate(app, config)
+		assert.NoError(t, err)
+		schemas := config.Swagger.Components.Schemas
+		assert.ElementsMatch(t, []string{"gofiberswagger.NamingUser", "gofiberswagger.NamingOther", "NamingUserAddress"}, sortedKeys(schemas))
+
+		// the references of the routes get renamed inside the document only
+		response := config.Swagger.Paths.Find("/users").Get.Responses.Value("200")
+		assert.Equal(t, "#/components/schemas/gofiberswagger.NamingUser", response.Value.Content.Get("application/json").Schema.Ref)
+		assert.Equal(t, "#/components/schemas/"+SchemaNamingFullPath(reflect.TypeOf(NamingUser{})), info.Responses.Value("200").Value.Content.Get("application/json").Schema.Ref)
+
+		// so do the components of the reused config
+		_, err = registry.generate(app, config)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"gofiberswagger.NamingUser", "gofiberswagger.NamingOther", "NamingUserAddress"}, sortedKeys(config.Swagger.Components.Schemas))
+	})
+
+	t.Run("anonymous collisions", func(t *testing.T) {
+		generate := func(create ...func(registry *Registry)) Schemas {
+			registry := NewRegistry(&Config{SchemaNaming: SchemaNamingShort})
+			for _, create := range create {
+				create(registry)
+			}
+			config := &Config{}
+			_, err := registry.generate(fiber.New(), config)
+			assert.NoError(t, err)
+			return config.Swagger.Components.Schemas
+		}
+		home := func(registry *Registry) { CreateSchemaIn[NamingHome](registry) }
+		work := func(registry *Registry) { CreateSchemaIn[NamingWork](registry) }
+
+		// the struct used by both is named after the first field in alphabetical order
+		for _, schemas := range []Schemas{generate(home, work), generate(work, home)} {
+			assert.ElementsMatch(t, []string{"NamingHome", "NamingWork", "NamingHomeAddress"}, sortedKeys(schemas))
+			assert.Equal(t, "#/components/schemas/NamingHomeAddress", schemas["NamingHome"].Value.Properties["address"].Ref)
+			assert.Equal(t, "#/components/schemas/NamingHomeAddress", schemas["NamingWork"].Value.Properties["address"].Ref)
+			assert.Equal(t, "NamingHomeAddress", schemas["NamingHomeAddress"].Value.Title)
+		}
+	})
+
+	t.Run("default", func(t *testing.T) {
+		schemas := generate(nil)
+		assert.Contains(t, schemas, "github_com_TDiblik_gofiber-swagger_gofiberswaggerNamingPageOfNamingUser")
+	})
+}
@@ -0,0 +1,102 @@
+package gofiberswagger
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Documentation tags of the struct fields                                      ///
+/// (`doc`, `example`, `format`, `pattern`, `default`, `deprecated`, ...)         ///
+/// ---------------------------------------------------------------------------- ///
+
+// returns the name of the documentation tag, prefixed by Config.TagPrefix
+func (r *Registry) tagName(name string) string {
+	if r.Config == nil {
+		return name
+	}
+	return r.Config.TagPrefix + name
+}
+
+// applies the documentation tags of the field to it's schema. Values of the `example` and `default`
+// tags are typed according to the schema, invalid boolean tags are ignored.
+func (r *Registry) applyDocumentationTags(field reflect.StructField, schema *Schema) {
+	if doc, ok := field.Tag.Lookup(r.tagName("doc")); ok {
+		schema.Description = doc
+	}
+	if example, ok := field.Tag.Lookup(r.tagName("example")); ok {
+		schema.Example = parseTagValue(schema, example)
+	}
+	if defaultValue, ok := field.Tag.Lookup(r.tagName("default")); ok {
+		schema.Default = parseTagValue(schema, defaultValue)
+	}
+	if format, ok := field.Tag.Lookup(r.tagName("format")); ok {
+		schema.Format = format
+	}
+	if pattern, ok := field.Tag.Lookup(r.tagName("pattern")); ok {
+		schema.Pattern = pattern
+	}
+	if deprecated, ok := r.lookupBoolTag(field, "deprecated"); ok {
+		schema.Deprecated = deprecated
+	}
+	if readOnly, ok := r.lookupBoolTag(field, "readonly"); ok {
+		schema.ReadOnly = readOnly
+	}
+	if writeOnly, ok := r.lookupBoolTag(field, "writeonly"); ok {
+		schema.WriteOnly = writeOnly
+	}
+}
+
+// parses the boolean tag, an empty value (`deprecated:""`) is considered true
+func (r *Registry) lookupBoolTag(field reflect.StructField, name string) (value bool, ok bool) {
+	tag, ok := field.Tag.Lookup(r.tagName(name))
+	if !ok {
+		return false, false
+	}
+	if tag == "" {
+		return true, true
+	}
+	value, err := strconv.ParseBool(tag)
+	return value, err == nil
+}
+
+// parses the value of a tag (`default:"..."`, `example:"..."`) based on the type of the schema.
+// Arrays are either JSON or comma separated, objects are JSON. Unparsable values are kept as strings.
+func parseTagValue(schema *Schema, value string) any {
+	switch {
+	case schema.Type.Is("integer"):
+		if parsed, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return parsed
+		}
+	case schema.Type.Is("number"):
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	case schema.Type.Is("boolean"):
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	case schema.Type.Is("array"):
+		var parsed []any
+		if strings.HasPrefix(value, "[") && json.Unmarshal([]byte(value), &parsed) == nil {
+			return parsed
+		}
+		result := []any{}
+		for _, item := range strings.Split(value, ",") {
+			if schema.Items != nil && schema.Items.Value != nil {
+				result = append(result, parseTagValue(schema.Items.Value, item))
+			} else {
+				result = append(result, item)
+			}
+		}
+		return result
+	case schema.Type.Is("object"):
+		var parsed map[string]any
+		if json.Unmarshal([]byte(value), &parsed) == nil {
+			return parsed
+		}
+	}
+	return value
+}
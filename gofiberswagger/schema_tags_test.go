@@ -0,0 +1,89 @@
+package gofiberswagger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type TaggedUser struct {
+	Email    string   `json:"email,omitempty" doc:"contact email" format:"email" example:"john@example.com"`
+	Age      int      `json:"age" example:"42" default:"18"`
+	Score    float64  `json:"score" example:"4.5"`
+	Active   bool     `json:"active" example:"true"`
+	Roles    []string `json:"roles" example:"admin,user"`
+	Numbers  []int    `json:"numbers" example:"[1, 2]"`
+	Code     string   `json:"code" pattern:"^[A-Z]{3}$"`
+	Legacy   string   `json:"legacy" deprecated:"true"`
+	ID       int64    `json:"id" readonly:""`
+	Password string   `json:"password" writeonly:"true"`
+	Invalid  string   `json:"invalid" readonly:"maybe"`
+}
+
+type PrefixedTaggedUser struct {
+	Name string `json:"name" doc:"ignored" oas_doc:"user name" oas_example:"John"`
+}
+
+type TaggedParameters struct {
+	Limit int    `query:"limit" doc:"page size" default:"20" example:"50"`
+	Sort  string `query:"sort" deprecated:"true"`
+}
+
+func TestSchema_DocumentationTags(t *testing.T) {
+	t.Parallel()
+
+	schema := CreateSchemaIn[TaggedUser](NewRegistry(nil))
+	properties := schema.Value.Properties
+
+	assert.Equal(t, "contact email", properties["email"].Value.Description)
+	assert.Equal(t, "email", properties["email"].Value.Format)
+	assert.Equal(t, "john@example.com", properties["email"].Value.Example)
+	assert.True(t, properties["email"].Value.Nullable)
+
+	// values are typed according to the field
+	assert.Equal(t, int64(42), properties["age"].Value.Example)
+	assert.Equal(t, int64(18), properties["age"].Value.Default)
+	assert.Equal(t, 4.5, properties["score"].Value.Example)
+	assert.Equal(t, true, properties["active"].Value.Example)
+	assert.Equal(t, []any{"admin", "user"}, properties["roles"].Value.Example)
+	assert.Equal(t, []any{float64(1), float64(2)}, properties["numbers"].Value.Example)
+
+	assert.Equal(t, "^[A-Z]{3}$", properties["code"].Value.Pattern)
+	assert.True(t, properties["legacy"].Value.Deprecated)
+	assert.True(t, properties["id"].Value.ReadOnly)
+	assert.True(t, properties["password"].Value.WriteOnly)
+	assert.False(t, properties["invalid"].Value.ReadOnly)
+
+	// no leftovers of the json options
+	for name, property := range properties {
+		if name != "email" {
+			assert.Empty(t, property.Value.Description, name)
+		}
+	}
+}
+
+func TestSchema_DocumentationTagsPrefix(t *testing.T) {
+	t.Parallel()
+
+	schema := CreateSchemaIn[PrefixedTaggedUser](NewRegistry(&Config{TagPrefix: "oas_"}))
+	name := schema.Value.Properties["name"].Value
+	assert.Equal(t, "user name", name.Description)
+	assert.Equal(t, "John", name.Example)
+}
+
+func TestParameters_DocumentationTags(t *testing.T) {
+	t.Parallel()
+
+	parameters := NewParametersFromIn[TaggedParameters](NewRegistry(nil))
+	assert.Len(t, parameters, 2)
+
+	limit := parameters[0].Value
+	assert.Equal(t, "page size", limit.Description)
+	assert.Empty(t, limit.Schema.Value.Description)
+	assert.Equal(t, int64(20), limit.Schema.Value.Default)
+	assert.Equal(t, int64(50), limit.Schema.Value.Example)
+
+	sort := parameters[1].Value
+	assert.True(t, sort.Deprecated)
+	assert.False(t, sort.Schema.Value.Deprecated)
+}
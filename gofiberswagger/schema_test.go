@@ -3,6 +3,8 @@ package gofiberswagger
 import (
 	"database/sql"
 	"mime/multipart"
+	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -199,3 +201,111 @@ func TestSchema_WithTags(t *testing.T) {
 	assert.Equal(t, "A", oneofSchema.Value.Enum[0])
 	assert.Equal(t, "B", oneofSchema.Value.Enum[1])
 }
+
+type RecursiveNode struct {
+	Value    string
+	Parent   *RecursiveNode
+	Children []RecursiveNode
+}
+
+func TestSchema_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	var wg sync.WaitGroup
+	for range 32 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			schema := CreateSchemaIn[RecursiveNode](registry)
+			assert.NotNil(t, schema)
+			assert.Equal(t, "RecursiveNode", schema.Value.Title)
+			CreateSchemaIn[ComplexTypes](registry)
+			CreateSchemaIn[WithEnums](registry)
+			NewRequestBody[WithTags]()
+			NewResponseInfo[RecursiveNode]("200", "ok")
+		}()
+	}
+	wg.Wait()
+
+	// placeholders must never end up in the acquired schemas
+	for name, schema := range registry.getAcquiredSchemas() {
+		assert.NotNil(t, schema.Value.Type, name)
+	}
+}
+
+type TopLevelItem struct {
+	Name string `json:"name"`
+}
+
+func TestSchema_TopLevelTypes(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	item_ref := "#/components/schemas/" + SchemaNamingFullPath(reflect.TypeOf(TopLevelItem{}))
+
+	t.Run("slice", func(t *testing.T) {
+		schema := CreateSchemaIn[[]TopLevelItem](registry)
+		assert.Equal(t, "array", (*schema.Value.Type)[0])
+		assert.Equal(t, item_ref, schema.Value.Items.Ref)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		schema := CreateSchemaIn[[3]int](registry)
+		assert.Equal(t, "array", (*schema.Value.Type)[0])
+		assert.Equal(t, "integer", (*schema.Value.Items.Value.Type)[0])
+	})
+
+	t.Run("nested slices of pointers", func(t *testing.T) {
+		schema := CreateSchemaIn[[][]*TopLevelItem](registry)
+		assert.Equal(t, "array", (*schema.Value.Type)[0])
+		assert.Equal(t, "array", (*schema.Value.Items.Value.Type)[0])
+		assert.Equal(t, item_ref, schema.Value.Items.Value.Items.Ref)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		schema := CreateSchemaIn[map[string]TopLevelItem](registry)
+		assert.Equal(t, "object", (*schema.Value.Type)[0])
+		assert.True(t, *schema.Value.AdditionalProperties.Has)
+		assert.Equal(t, item_ref, schema.Value.AdditionalProperties.Schema.Ref)
+	})
+
+	t.Run("map of slices", func(t *testing.T) {
+		schema := CreateSchemaIn[map[string][]TopLevelItem](registry)
+		assert.Equal(t, "object", (*schema.Value.Type)[0])
+		values := schema.Value.AdditionalProperties.Schema
+		assert.Equal(t, "array", (*values.Value.Type)[0])
+		assert.Equal(t, item_ref, values.Value.Items.Ref)
+	})
+
+	t.Run("special types", func(t *testing.T) {
+		bytes_schema := CreateSchemaIn[[]byte](registry)
+		assert.Equal(t, "string", (*bytes_schema.Value.Type)[0])
+		assert.Equal(t, "byte", bytes_schema.Value.Format)
+
+		time_schema := CreateSchemaIn[*time.Time](registry)
+		assert.Equal(t, "date-time", time_schema.Value.Format)
+
+		times_schema := CreateSchemaIn[[]time.Time](registry)
+		assert.Equal(t, "date-time", times_schema.Value.Items.Value.Format)
+
+		uuid_schema := CreateSchemaIn[uuid.UUID](registry)
+		assert.Equal(t, "uuid", uuid_schema.Value.Format)
+
+		null_schema := CreateSchemaIn[sql.NullString](registry)
+		assert.Equal(t, "string", (*null_schema.Value.Type)[0])
+		assert.True(t, null_schema.Value.Nullable)
+	})
+
+	t.Run("response", func(t *testing.T) {
+		response := NewResponseInfo[[]TopLevelItem]("200", "ok")
+		schema := response.Response.Value.Content.Get("application/json").Schema
+		assert.Equal(t, "array", (*schema.Value.Type)[0])
+		assert.NotNil(t, schema.Value.Items.Ref)
+	})
+
+	// only structs are components
+	for name := range registry.getAcquiredSchemas() {
+		assert.Equal(t, SchemaNamingFullPath(reflect.TypeOf(TopLevelItem{})), name)
+	}
+}
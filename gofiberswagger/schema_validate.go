@@ -0,0 +1,380 @@
+package gofiberswagger
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Translation of the go-playground/validator rules (`validate:"..."`)          ///
+/// into formats, patterns, bounds, item schemas and descriptions                ///
+/// ---------------------------------------------------------------------------- ///
+
+// validators which correspond to an OpenAPI format
+var validatorFormats = map[string]string{
+	"email":            "email",
+	"url":              "uri",
+	"http_url":         "uri",
+	"uri":              "uri",
+	"uuid":             "uuid",
+	"uuid3":            "uuid",
+	"uuid4":            "uuid",
+	"uuid5":            "uuid",
+	"uuid_rfc4122":     "uuid",
+	"uuid3_rfc4122":    "uuid",
+	"uuid4_rfc4122":    "uuid",
+	"uuid5_rfc4122":    "uuid",
+	"ip":               "ip",
+	"ip_addr":          "ip",
+	"ipv4":             "ipv4",
+	"ip4_addr":         "ipv4",
+	"ipv6":             "ipv6",
+	"ip6_addr":         "ipv6",
+	"cidr":             "cidr",
+	"cidrv4":           "cidr",
+	"cidrv6":           "cidr",
+	"hostname":         "hostname",
+	"hostname_rfc1123": "hostname",
+	"fqdn":             "hostname",
+	"mac":              "mac",
+	"base64":           "byte",
+	"jwt":              "jwt",
+	"ulid":             "ulid",
+}
+
+// validators which correspond to a pattern (compatible with both, Go and ECMA-262 regular expressions)
+var validatorPatterns = map[string]string{
+	"alpha":       `^[a-zA-Z]+$`,
+	"alphanum":    `^[a-zA-Z0-9]+$`,
+	"numeric":     `^[-+]?[0-9]+(?:\.[0-9]+)?$`,
+	"number":      `^[0-9]+$`,
+	"hexadecimal": `^(0[xX])?[0-9a-fA-F]+$`,
+	"hexcolor":    `^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`,
+	"e164":        `^\+[1-9]?[0-9]{7,14}$`,
+	"ascii":       `^[\x00-\x7F]*$`,
+	"printascii":  `^[\x20-\x7E]*$`,
+	"lowercase":   `^[^A-Z]*$`,
+	"uppercase":   `^[^a-z]*$`,
+}
+
+// the formats of the common `datetime=...` layouts
+var validatorDatetimeFormats = map[string]string{
+	time.DateOnly: "date",
+	time.TimeOnly: "time",
+	time.RFC3339:  "date-time",
+}
+
+// validators of conditionally required / excluded fields, documented as descriptions
+var validatorConditions = map[string]string{
+	"required_if":          "Required if %s.",
+	"required_unless":      "Required unless %s.",
+	"required_with":        "Required if any of %s is present.",
+	"required_with_all":    "Required if all of %s are present.",
+	"required_without":     "Required if any of %s is missing.",
+	"required_without_all": "Required if all of %s are missing.",
+	"excluded_if":          "Must be omitted if %s.",
+	"excluded_unless":      "Must be omitted unless %s.",
+	"excluded_with":        "Must be omitted if any of %s is present.",
+	"excluded_with_all":    "Must be omitted if all of %s are present.",
+	"excluded_without":     "Must be omitted if any of %s is missing.",
+	"excluded_without_all": "Must be omitted if all of %s are missing.",
+}
+
+// applies the rules of the `validate:"..."` tag to the schema of the field (or parameter). Rules after `dive`
+// apply to the items of slices / values of maps, rules without an OpenAPI equivalent are kept inside the
+// `x-validate` extension. Returns the descriptions of the conditional rules (required_if, excluded_with, ...),
+// which get appended to the description once the documentation tags are applied.
+// call only while holding r.schemasMutex!
+func (r *Registry) applyValidateTag(validateTag string, fieldType reflect.Type, result *SchemaRef) (required bool, notes []string) {
+	if validateTag == "" || validateTag == "-" {
+		return false, nil
+	}
+	return r.applyValidations(splitValidateTag(validateTag), fieldType, result, true)
+}
+
+// call only while holding r.schemasMutex!
+func (r *Registry) applyValidations(validations []string, fieldType reflect.Type, result *SchemaRef, isField bool) (required bool, notes []string) {
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	fieldKind := fieldType.Kind()
+	schema := result.Value
+	unknown := []string{}
+
+	for i := 0; i < len(validations); i++ {
+		validation := validations[i]
+		name, param, _ := strings.Cut(validation, "=")
+
+		switch {
+		case validation == "":
+		case validation == "required":
+			schema.Nullable = false
+			schema.AllowEmptyValue = false
+			required = isField
+		case validation == "omitempty", validation == "omitzero":
+		case validation == "omitnil":
+			schema.Nullable = true
+
+		case name == "dive":
+			rest := validations[i+1:]
+			i = len(validations)
+			if !r.applyDiveValidations(rest, fieldType, schema) {
+				unknown = append(unknown, append([]string{"dive"}, rest...)...)
+			}
+
+		case name == "len", name == "min", name == "max", name == "gt", name == "gte", name == "lt", name == "lte":
+			if !applyValidatorBound(schema, fieldKind, name, param) {
+				unknown = append(unknown, validation)
+			}
+		case name == "minLength":
+			if minLen, err := strconv.ParseUint(param, 10, 64); err == nil {
+				schema.MinLength = minLen
+			}
+		case name == "maxLength":
+			if maxLen, err := strconv.ParseUint(param, 10, 64); err == nil {
+				schema.MaxLength = &maxLen
+			}
+		case name == "uniqueItems", name == "unique" && param == "" && (fieldKind == reflect.Slice || fieldKind == reflect.Array):
+			schema.UniqueItems = true
+
+		case name == "oneof":
+			// oneof is more important than all other options since that's what the validator is using...
+			// in that case, ignore and overwrite every other enum / OneOf options
+			options := []any{}
+			for _, option := range splitOneOfParam(param) {
+				options = append(options, option)
+			}
+			r.handleEnumValues(result, options, true, fieldType)
+
+		case validatorFormats[name] != "" && param == "":
+			schema.Format = validatorFormats[name]
+		case validatorPatterns[name] != "" && param == "":
+			if !setValidatorPattern(schema, validatorPatterns[name]) {
+				unknown = append(unknown, validation)
+			}
+		case name == "contains" && param != "":
+			if !setValidatorPattern(schema, regexp.QuoteMeta(param)) {
+				unknown = append(unknown, validation)
+			}
+		case name == "startswith" && param != "":
+			if !setValidatorPattern(schema, "^"+regexp.QuoteMeta(param)) {
+				unknown = append(unknown, validation)
+			}
+		case name == "endswith" && param != "":
+			if !setValidatorPattern(schema, regexp.QuoteMeta(param)+"$") {
+				unknown = append(unknown, validation)
+			}
+		case name == "datetime" && param != "":
+			if format, ok := validatorDatetimeFormats[param]; ok {
+				schema.Format = format
+			} else {
+				notes = append(notes, fmt.Sprintf("Formatted using the Go time layout `%s`.", param))
+			}
+
+		case validatorConditions[name] != "" && param != "":
+			notes = append(notes, fmt.Sprintf(validatorConditions[name], describeValidatorCondition(name, param)))
+
+		default:
+			unknown = append(unknown, validation)
+		}
+	}
+
+	// the zero value defaults don't have to satisfy the constraints (e.g. `gt=0`)
+	if schema.Default != nil && schema.VisitJSON(schema.Default) != nil {
+		schema.Default = nil
+	}
+
+	if len(unknown) > 0 {
+		extensions := make(map[string]any, len(schema.Extensions)+1)
+		for key, value := range schema.Extensions {
+			extensions[key] = value
+		}
+		extensions["x-validate"] = strings.Join(unknown, ",")
+		schema.Extensions = extensions
+	}
+
+	return required, notes
+}
+
+// applies the validations following `dive` to the items (slices, arrays) or values (maps) of the schema.
+// Key validations of maps (`keys,...,endkeys`) are kept inside the `x-validate` extension of the map.
+// Returns false if the schema has no items to dive into.
+// call only while holding r.schemasMutex!
+func (r *Registry) applyDiveValidations(validations []string, fieldType reflect.Type, schema *Schema) bool {
+	var element **SchemaRef
+	switch {
+	case (fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array) && schema.Items != nil:
+		element = &schema.Items
+	case fieldType.Kind() == reflect.Map && schema.AdditionalProperties.Schema != nil:
+		element = &schema.AdditionalProperties.Schema
+	default:
+		return false
+	}
+
+	if len(validations) > 0 && validations[0] == "keys" && fieldType.Kind() == reflect.Map {
+		end := len(validations)
+		for i, validation := range validations {
+			if validation == "endkeys" {
+				end = i + 1
+				break
+			}
+		}
+		extensions := make(map[string]any, len(schema.Extensions)+1)
+		for key, value := range schema.Extensions {
+			extensions[key] = value
+		}
+		extensions["x-validate"] = "dive," + strings.Join(validations[:end], ",")
+		schema.Extensions = extensions
+		validations = validations[end:]
+	}
+
+	// the items may be shared with other schemas (components, registered types), modify a copy
+	if *element == nil || (*element).Value == nil {
+		return true
+	}
+	value := *(*element).Value
+	items := &SchemaRef{Ref: (*element).Ref, Extensions: (*element).Extensions, Origin: (*element).Origin, Value: &value}
+	_, notes := r.applyValidations(validations, fieldType.Elem(), items, false)
+	appendDescription(items.Value, notes...)
+	*element = items
+
+	return true
+}
+
+// applies the len/min/max/gt/gte/lt/lte validators: bounds of numbers, lengths of strings,
+// number of items of slices / arrays and number of properties of maps.
+// Returns false if the validator can't be expressed (e.g. `gt` of time.Time).
+func applyValidatorBound(schema *Schema, kind reflect.Kind, name string, param string) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return false
+		}
+		switch name {
+		case "len":
+			schema.Min, schema.Max = &value, &value
+		case "min":
+			schema.Min = &value
+			schema.Default = value
+		case "gte":
+			schema.Min = &value
+		case "gt":
+			schema.Min = &value
+			schema.ExclusiveMin = true
+		case "max", "lte":
+			schema.Max = &value
+		case "lt":
+			schema.Max = &value
+			schema.ExclusiveMax = true
+		}
+		return true
+
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		value, err := strconv.ParseUint(param, 10, 64)
+		if err != nil {
+			return false
+		}
+		minValue, maxValue := uint64(0), (*uint64)(nil)
+		switch name {
+		case "len":
+			minValue, maxValue = value, &value
+		case "min", "gte":
+			minValue = value
+		case "gt":
+			minValue = value + 1
+		case "max", "lte":
+			maxValue = &value
+		case "lt":
+			if value == 0 {
+				return false
+			}
+			lessValue := value - 1
+			maxValue = &lessValue
+		}
+		switch kind {
+		case reflect.String:
+			schema.MinLength = max(schema.MinLength, minValue)
+			if maxValue != nil {
+				schema.MaxLength = maxValue
+			}
+		case reflect.Map:
+			schema.MinProps = max(schema.MinProps, minValue)
+			if maxValue != nil {
+				schema.MaxProps = maxValue
+			}
+		default:
+			schema.MinItems = max(schema.MinItems, minValue)
+			if maxValue != nil {
+				schema.MaxItems = maxValue
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// sets the pattern of the schema, returns false if the schema already has a different one
+func setValidatorPattern(schema *Schema, pattern string) bool {
+	if schema.Pattern != "" && schema.Pattern != pattern {
+		return false
+	}
+	schema.Pattern = pattern
+	return true
+}
+
+// describes the parameter of a conditional validator:
+// `Field value Other value` (required_if, ...) or `Field Other` (required_with, ...)
+func describeValidatorCondition(name string, param string) string {
+	fields := strings.Fields(param)
+	if name != "required_if" && name != "required_unless" && name != "excluded_if" && name != "excluded_unless" {
+		return "`" + strings.Join(fields, "`, `") + "`"
+	}
+
+	conditions := []string{}
+	for i := 0; i+1 < len(fields); i += 2 {
+		conditions = append(conditions, fmt.Sprintf("`%s` is `%s`", fields[i], fields[i+1]))
+	}
+	return strings.Join(conditions, " and ")
+}
+
+// appends the sentences to the description of the schema
+func appendDescription(schema *Schema, sentences ...string) {
+	for _, sentence := range sentences {
+		if schema.Description != "" {
+			schema.Description += " "
+		}
+		schema.Description += sentence
+	}
+}
+
+// splits the tag into the validations, the same way as the validator (commas can be escaped as `0x2C`)
+func splitValidateTag(validateTag string) []string {
+	validations := strings.Split(validateTag, ",")
+	for i, validation := range validations {
+		validations[i] = strings.ReplaceAll(validation, "0x2C", ",")
+	}
+	return validations
+}
+
+// splits the options of `oneof=...`, options containing spaces are quoted (`oneof='red green' blue`)
+func splitOneOfParam(param string) []string {
+	options := []string{}
+	for _, match := range oneOfOptionsRegex.FindAllStringSubmatch(param, -1) {
+		if match[1] != "" {
+			options = append(options, match[1])
+		} else {
+			options = append(options, match[2])
+		}
+	}
+	return options
+}
+
+var oneOfOptionsRegex = regexp.MustCompile(`'([^']*)'|(\S+)`)
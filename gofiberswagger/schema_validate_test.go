@@ -0,0 +1,117 @@
+package gofiberswagger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ValidatedUser struct {
+	Code       string            `json:"code" validate:"len=3,alpha"`
+	Age        int               `json:"age" validate:"gt=0,lt=150"`
+	Score      float64           `json:"score" validate:"gte=0,lte=1"`
+	Name       string            `json:"name" validate:"omitempty,gt=2,lte=10,alphanum"`
+	Email      string            `json:"email" validate:"required,email"`
+	Website    string            `json:"website" validate:"url"`
+	ID         string            `json:"id" validate:"uuid4"`
+	IP         string            `json:"ip" validate:"ip"`
+	Host       string            `json:"host" validate:"hostname"`
+	Birthday   string            `json:"birthday" validate:"datetime=2006-01-02"`
+	Clock      string            `json:"clock" validate:"datetime=15:04"`
+	Phone      string            `json:"phone" validate:"e164"`
+	Tags       []string          `json:"tags" validate:"min=1,dive,min=2,max=5"`
+	Emails     []string          `json:"emails" validate:"dive,email"`
+	Colors     []string          `json:"colors" validate:"dive,oneof='light red' blue"`
+	Matrix     [][]int           `json:"matrix" validate:"len=2,dive,len=2,dive,gte=0"`
+	Labels     map[string]string `json:"labels" validate:"max=3,dive,keys,alpha,endkeys,required"`
+	Partner    string            `json:"partner" validate:"required_if=Married true"`
+	Fax        string            `json:"fax" validate:"required_without=Phone Email"`
+	Secret     string            `json:"secret" validate:"excluded_with=Code"`
+	Custom     string            `json:"custom" validate:"required,is-awesome,eqfield=Name"`
+	Nickname   *string           `json:"nickname" validate:"omitnil,min=1"`
+	Married    bool              `json:"married"`
+	Documented string            `json:"documented" doc:"documented field" validate:"required_with=Code"`
+}
+
+func TestSchema_ValidateTag(t *testing.T) {
+	t.Parallel()
+
+	schema := CreateSchemaIn[ValidatedUser](NewRegistry(nil))
+	properties := schema.Value.Properties
+
+	// bounds
+	code := properties["code"].Value
+	assert.Equal(t, uint64(3), code.MinLength)
+	assert.Equal(t, uint64(3), *code.MaxLength)
+	assert.Equal(t, `^[a-zA-Z]+$`, code.Pattern)
+
+	age := properties["age"].Value
+	assert.Equal(t, float64(0), *age.Min)
+	assert.True(t, age.ExclusiveMin)
+	assert.Equal(t, float64(150), *age.Max)
+	assert.True(t, age.ExclusiveMax)
+	assert.Nil(t, age.Default)
+
+	score := properties["score"].Value
+	assert.Equal(t, float64(0), *score.Min)
+	assert.Equal(t, float64(1), *score.Max)
+	assert.False(t, score.ExclusiveMin)
+
+	name := properties["name"].Value
+	assert.Equal(t, uint64(3), name.MinLength)
+	assert.Equal(t, uint64(10), *name.MaxLength)
+	assert.Equal(t, `^[a-zA-Z0-9]+$`, name.Pattern)
+
+	// formats & patterns
+	assert.Equal(t, "email", properties["email"].Value.Format)
+	assert.Contains(t, schema.Value.Required, "email")
+	assert.Equal(t, "uri", properties["website"].Value.Format)
+	assert.Equal(t, "uuid", properties["id"].Value.Format)
+	assert.Equal(t, "ip", properties["ip"].Value.Format)
+	assert.Equal(t, "hostname", properties["host"].Value.Format)
+	assert.Equal(t, "date", properties["birthday"].Value.Format)
+	assert.Equal(t, "Formatted using the Go time layout `15:04`.", properties["clock"].Value.Description)
+	assert.Equal(t, `^\+[1-9]?[0-9]{7,14}$`, properties["phone"].Value.Pattern)
+
+	// dive
+	tags := properties["tags"].Value
+	assert.Equal(t, uint64(1), tags.MinItems)
+	assert.Equal(t, uint64(2), tags.Items.Value.MinLength)
+	assert.Equal(t, uint64(5), *tags.Items.Value.MaxLength)
+	assert.Equal(t, "email", properties["emails"].Value.Items.Value.Format)
+	assert.Equal(t, []any{"light red", "blue"}, properties["colors"].Value.Items.Value.Enum)
+
+	matrix := properties["matrix"].Value
+	assert.Equal(t, uint64(2), matrix.MinItems)
+	assert.Equal(t, uint64(2), *matrix.Items.Value.MaxItems)
+	assert.Equal(t, float64(0), *matrix.Items.Value.Items.Value.Min)
+
+	labels := properties["labels"].Value
+	assert.Equal(t, uint64(3), *labels.MaxProps)
+	assert.Equal(t, "dive,keys,alpha,endkeys", labels.Extensions["x-validate"])
+	assert.Empty(t, labels.AdditionalProperties.Schema.Value.Extensions)
+
+	// conditions
+	assert.Equal(t, "Required if `Married` is `true`.", properties["partner"].Value.Description)
+	assert.Equal(t, "Required if any of `Phone`, `Email` is missing.", properties["fax"].Value.Description)
+	assert.Equal(t, "Must be omitted if any of `Code` is present.", properties["secret"].Value.Description)
+	assert.Equal(t, "documented field Required if any of `Code` is present.", properties["documented"].Value.Description)
+	assert.NotContains(t, schema.Value.Required, "partner")
+
+	// unknown validators
+	custom := properties["custom"].Value
+	assert.Equal(t, "is-awesome,eqfield=Name", custom.Extensions["x-validate"])
+	assert.Contains(t, schema.Value.Required, "custom")
+	assert.Nil(t, properties["code"].Value.Extensions["x-validate"])
+
+	nickname := properties["nickname"].Value
+	assert.True(t, nickname.Nullable)
+	assert.Equal(t, uint64(1), nickname.MinLength)
+}
+
+func TestSplitOneOfParam(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"a", "b"}, splitOneOfParam("a b"))
+	assert.Equal(t, []string{"light red", "blue", ""}, splitOneOfParam("'light red' blue ''"))
+}
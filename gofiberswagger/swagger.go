@@ -1,57 +1,144 @@
 package gofiberswagger
 
 import (
-	"bytes"
+	"encoding/json"
 	"errors"
-	"html/template"
 	"log"
 	"os"
 	"path/filepath"
-	"strconv"
+	"slices"
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
 	"github.com/gofiber/fiber/v3"
 	"gopkg.in/yaml.v3"
 )
 
+// DocsHandlers serve the generated documentation. Use them when you want to mount the docs yourself.
+type DocsHandlers struct {
+	Index fiber.Handler
+	JSON  fiber.Handler
+	YAML  fiber.Handler
+	// Serves the swagger ui assets (file name is taken from the last path segment).
+	// nil, unless SwaggerUI.AssetsSource is AssetsSourceEmbedded.
+	Assets fiber.Handler
+	// Pages of Config.AdditionalRenderers keyed by their sub-path.
+	Renderers map[string]fiber.Handler
+	// Serve the assets of the Config.AdditionalRenderers using swagger ui with embedded assets, keyed by their sub-path
+	// (the assets are loaded from the sub-path, e.g. BasePath + "/ui/swagger-ui.css").
+	RenderersAssets map[string]fiber.Handler
+	// Issues found by the linter (see Config.Lint).
+	LintIssues []LintIssue
+}
+
+// Register generates the documentation for the app using the DefaultRegistry
+// and serves it on config.DocsRouter (defaults to the app itself).
 func Register(app *fiber.App, config *Config) error {
-	config.Swagger = swaggerConfigDefault(config.Swagger)
-	config.SwaggerUI = swaggerUIConfigDefault(config.SwaggerUI)
+	return DefaultRegistry.register(app, config)
+}
+
+// GenerateHandlers generates the documentation for the app using the DefaultRegistry,
+// but instead of registering the docs routes, it returns their handlers.
+func GenerateHandlers(app *fiber.App, config *Config) (*DocsHandlers, error) {
+	return DefaultRegistry.generate(app, config)
+}
+
+func (r *Registry) register(app *fiber.App, config *Config) error {
+	if config == nil {
+		config = &Config{}
+		*config = DefaultConfig
+	}
+
+	handlers, err := r.generate(app, config)
+	if err != nil {
+		return err
+	}
+
+	docs_router := config.DocsRouter
+	if docs_router == nil {
+		docs_router = app
+	}
+	swagger_routes := docs_router.Group(config.BasePath, config.DocsMiddleware...)
+	swagger_routes.Get("/", handlers.Index)
+	swagger_routes.Get("/"+config.IndexFileName, handlers.Index)
+	swagger_routes.Get("/"+config.JSONFileName, handlers.JSON)
+	swagger_routes.Get("/"+config.YAMLFileName, handlers.YAML)
+	if handlers.Assets != nil {
+		for _, file := range swaggerUIAssetFiles {
+			swagger_routes.Get("/"+file, handlers.Assets)
+		}
+	}
+	for sub_path, handler := range handlers.Renderers {
+		swagger_routes.Get("/"+sub_path, handler)
+	}
+	for sub_path, handler := range handlers.RenderersAssets {
+		for _, file := range swaggerUIAssetFiles {
+			swagger_routes.Get("/"+sub_path+"/"+file, handler)
+		}
+	}
+
+	// Deprecated: the UI used to be served at /swagger/swagger as well, which now redirects to the docs.
+	// Will be removed in the next major version.
+	if isLegacyDocsPathFree(config, handlers) {
+		docs_path := getDocsPath(config) + "/"
+		docs_router.Get(legacyDocsPath, func(c fiber.Ctx) error {
+			return c.Redirect().Status(fiber.StatusMovedPermanently).To(docs_path)
+		})
+	}
+
+	return nil
+}
+
+const legacyDocsPath = "/swagger/swagger"
 
-	for k, v := range acquiredSchemas {
+// whether the legacy path belongs to the docs, but isn't served by them. Docs moved elsewhere (custom BasePath)
+// leave the legacy path to the app.
+func isLegacyDocsPathFree(config *Config, handlers *DocsHandlers) bool {
+	if config.BasePath != DefaultConfig.BasePath {
+		return false
+	}
+	if slices.Contains([]string{config.IndexFileName, config.JSONFileName, config.YAMLFileName}, "swagger") {
+		return false
+	}
+	_, ok := handlers.Renderers["swagger"]
+	return !ok
+}
+
+func (r *Registry) generate(app *fiber.App, config *Config) (*DocsHandlers, error) {
+	r.registerMutex.Lock()
+	defer r.registerMutex.Unlock()
+
+	if config == nil {
+		config = &Config{}
+		*config = DefaultConfig
+	}
+	configDefault(config)
+
+	// components generated for the routes (including the ones generated by helpers like NewRequestBody[T],
+	// which are shared by all the registries) are added once the paths are known
+	acquired_schemas := r.getAcquiredSchemas()
+	for _, k := range r.getCreatedSchemas() {
 		if config.Swagger.Components.Schemas[k] == nil {
-			config.Swagger.Components.Schemas[k] = v
+			config.Swagger.Components.Schemas[k] = acquired_schemas[k]
 		}
 	}
 
 	routes := app.GetRoutes(config.FilterOutAppUse)
+	validation_routes := []*validationRoute{}
 	for _, route := range routes {
-		operation := getAcquiredRoutesInfo(route.Method, route.Path)
-		if operation == nil {
-			operation = &RouteInfo{}
-		}
-
-		corrected_path := route.Path
-		for _, param_name := range route.Params {
-			parameter := NewPathParameter(param_name)
-			parameter.Value = parameter.Value.WithSchema(NewStringSchema())
-			operation.AddParameter(parameter.Value)
-
-			corrected_path = strings.Replace(corrected_path, ":"+param_name, "{"+param_name+"}", 1)
-			if param_name[0] == '*' || param_name[0] == '+' {
-				char_to_replace := "*"
-				if param_name[0] == '+' {
-					char_to_replace = "+"
-				}
-
-				nth, err := strconv.ParseUint(strings.ReplaceAll(param_name, char_to_replace, ""), 10, 64)
-				if err != nil {
-					return errors.Join(errors.New("unable to parse out the nth position of the param_name \""+param_name+"\""), err)
-				}
-				corrected_path = replaceNthOccurrence(corrected_path, char_to_replace, "{"+param_name+"}", int(nth))
-			}
+		// work on a copy, so that the registered info can be safely used by multiple (possibly concurrent) registrations.
+		// The docs of the groups are applied now, so that groups documented after their routes are taken into account
+		operation := copyRouteInfo(r.getAcquiredRoutesInfo(route.Method, route.Path))
+
+		handler := r.getRouteHandler(route.Method, route.Path)
+		if handler == nil && len(route.Handlers) > 0 {
+			handler = route.Handlers[len(route.Handlers)-1]
+		}
+		if config.UseDocComments {
+			applyOperationDocComment(operation, docComments.function(handler))
 		}
+
 		if config.AppendMethodToTags {
 			operation.Tags = append(operation.Tags, route.Method)
 		}
@@ -65,127 +152,244 @@ func Register(app *fiber.App, config *Config) error {
 			}
 		}
 
-		if operation.Responses == nil {
-			operation.Responses = &Responses{}
+		variants := convertFiberPath(route.Path)
+		variants_operations := make([]*RouteInfo, len(variants))
+		for i, variant := range variants {
+			variants_operations[i] = copyRouteInfo(operation)
+			addPathParameters(variants_operations[i], variant)
 		}
+		generateOperationIDs(config, OperationIDRoute{Method: route.Method, Name: route.Name, Handler: getHandlerName(handler)}, variants, variants_operations)
+
+		for i, variant := range variants {
+			variant_operation := variants_operations[i]
+
+			path_item := config.Swagger.Paths.Find(variant.Path)
+			if path_item == nil {
+				path_item = &openapi3.PathItem{}
+			}
+			switch route.Method {
+			case "POST":
+				path_item.Post = variant_operation
+			case "CONNECT":
+				path_item.Connect = variant_operation
+			case "DELETE":
+				path_item.Delete = variant_operation
+			case "GET":
+				path_item.Get = variant_operation
+			case "HEAD":
+				path_item.Head = variant_operation
+			case "OPTIONS":
+				path_item.Options = variant_operation
+			case "PATCH":
+				path_item.Patch = variant_operation
+			case "PUT":
+				path_item.Put = variant_operation
+			case "TRACE":
+				path_item.Trace = variant_operation
+			default:
+				log.Println("gofiber-swagger: unable to translate operation \"", route.Method, "\", skipping...")
+			}
+			config.Swagger.Paths.Set(variant.Path, path_item)
+			if path_item.GetOperation(route.Method) != variant_operation {
+				continue
+			}
 
-		path_item := config.Swagger.Paths.Find(corrected_path)
-		if path_item == nil {
-			path_item = &openapi3.PathItem{}
+			validation_route := newValidationRoute(variant, app.Config().CaseSensitive, app.Config().StrictRouting)
+			validation_route.route = &routers.Route{
+				Spec:      &config.Swagger,
+				Path:      variant.Path,
+				PathItem:  path_item,
+				Method:    route.Method,
+				Operation: variant_operation,
+			}
+			validation_routes = append(validation_routes, validation_route)
 		}
-		switch route.Method {
-		case "POST":
-			path_item.Post = operation
-		case "CONNECT":
-			path_item.Connect = operation
-		case "DELETE":
-			path_item.Delete = operation
-		case "GET":
-			path_item.Get = operation
-		case "HEAD":
-			path_item.Head = operation
-		case "OPTIONS":
-			path_item.Options = operation
-		case "PATCH":
-			path_item.Patch = operation
-		case "PUT":
-			path_item.Put = operation
-		case "TRACE":
-			path_item.Trace = operation
-		default:
-			log.Println("gofiber-swagger: unable to translate operation \"", route.Method, "\", skipping...")
+	}
+	r.addReferencedSchemas(config.Swagger.Components.Schemas, config.Swagger.Paths)
+	r.addGroupsTags(&config.Swagger)
+	if config.UseDocComments {
+		for ref, t := range r.getAcquiredSchemasTypes() {
+			if schema, ok := config.Swagger.Components.Schemas[ref]; ok {
+				config.Swagger.Components.Schemas[ref] = applySchemaDocComments(schema, t, r.tagName("doc"))
+			}
 		}
-		config.Swagger.Paths.Set(corrected_path, path_item)
 	}
+	r.nameSchemaComponents(&config.Swagger)
 
 	if config.CallbackBeforeGenerate != nil {
 		err := config.CallbackBeforeGenerate(config)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	index_page, err := generateIndexPage(swaggerUIConfigDefault(config.SwaggerUI))
+	if config.OperationIDStrategy != nil {
+		if err := checkOperationIDsUniqueness(&config.Swagger); err != nil {
+			return nil, err
+		}
+	}
+	// the operations are linted as declared, before the validation fills their missing responses
+	lint_issues, err := lintDocument(config)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if config.ValidateSpec {
+		addDefaultResponses(&config.Swagger)
+		if err := validateDocument(&config.Swagger); err != nil {
+			return nil, errors.Join(errors.New("gofiber-swagger: the generated document is invalid -> "), err)
+		}
+	}
+
+	var assets_handler fiber.Handler
+	if config.SwaggerUI.AssetsSource == AssetsSourceEmbedded {
+		if err := validateSwaggerUIAssets(config.SwaggerUI.AssetsFS); err != nil {
+			return nil, err
+		}
+		assets_handler = newSwaggerUIAssetsHandler(config.SwaggerUI.AssetsFS)
+	}
+
+	var index_page []byte
+	if config.Renderer != nil {
+		renderer, renderer_assets_handler, err := prepareRendererAssets(config.Renderer, getDocsPath(config))
+		if err != nil {
+			return nil, err
+		}
+		if renderer_assets_handler != nil {
+			assets_handler = renderer_assets_handler
+		}
+		index_page, err = renderer.Render(getSpecURL(config))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		index_page, err = generateIndexPage(config.SwaggerUI)
+		if err != nil {
+			return nil, err
+		}
+	}
+	renderers_handlers := make(map[string]fiber.Handler, len(config.AdditionalRenderers))
+	renderers_assets_handlers := map[string]fiber.Handler{}
+	for sub_path, renderer := range config.AdditionalRenderers {
+		sub_path = strings.Trim(sub_path, "/")
+		renderer, renderer_assets_handler, err := prepareRendererAssets(renderer, getDocsPath(config)+"/"+sub_path)
+		if err != nil {
+			return nil, err
+		}
+		if renderer_assets_handler != nil {
+			renderers_assets_handlers[sub_path] = renderer_assets_handler
+		}
+		page, err := renderer.Render(getSpecURL(config))
+		if err != nil {
+			return nil, err
+		}
+		renderers_handlers[sub_path] = func(c fiber.Ctx) error {
+			return c.Type("html").Send(page)
+		}
 	}
+	r.setValidationRoutes(validation_routes)
 	schema_as_json, schema_as_yaml, err := generateOpenApiSchema(config.Swagger)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if config.CreateSwaggerFiles && !fiber.IsChild() {
 		if config.SwaggerFilesPath == "" {
-			return errors.New("gofiber-swagger: CreateSwaggerFiles was set to true, however SwaggerFilesPaths was left empty")
+			return nil, errors.New("gofiber-swagger: CreateSwaggerFiles was set to true, however SwaggerFilesPaths was left empty")
 		}
-		createSwaggerFiles(config.SwaggerFilesPath, index_page, schema_as_json, schema_as_yaml)
+		createSwaggerFiles(config, index_page, schema_as_json, schema_as_yaml)
 	}
 
-	swagger_routes := app.Group("/swagger")
-	index_handler := func(c fiber.Ctx) error {
-		return c.Type("html").Send(index_page)
+	return &DocsHandlers{
+		Index: func(c fiber.Ctx) error {
+			return c.Type("html").Send(index_page)
+		},
+		JSON: func(c fiber.Ctx) error {
+			return c.Type("json").Send(schema_as_json)
+		},
+		YAML: func(c fiber.Ctx) error {
+			return c.Type("yaml").Send(schema_as_yaml)
+		},
+		Assets:          assets_handler,
+		Renderers:       renderers_handlers,
+		RenderersAssets: renderers_assets_handlers,
+		LintIssues:      lint_issues,
+	}, nil
+}
+
+// adds the parameters of the path variant, which weren't declared by the user
+// and removes declared path parameters, which are not part of the variant (omitted optional parameters)
+func addPathParameters(operation *RouteInfo, variant fiberPathVariant) {
+	parameters := Parameters{}
+	for _, parameter := range operation.Parameters {
+		if parameter != nil && parameter.Value != nil && parameter.Value.In == openapi3.ParameterInPath && !slices.ContainsFunc(variant.Params, func(path_param fiberPathParam) bool {
+			return path_param.Name == parameter.Value.Name
+		}) {
+			continue
+		}
+		parameters = append(parameters, parameter)
 	}
-	swagger_routes.Get("/", index_handler)
-	swagger_routes.Get("/index.html", index_handler)
-	swagger_routes.Get("/swagger", index_handler)
-	swagger_routes.Get("/swagger.json", func(c fiber.Ctx) error {
-		return c.Type("json").Send(schema_as_json)
-	})
-	swagger_routes.Get("/swagger.yaml", func(c fiber.Ctx) error {
-		return c.Type("yaml").Send(schema_as_yaml)
-	})
+	operation.Parameters = parameters
 
-	return nil
+	for _, path_param := range variant.Params {
+		// parameters declared by the user take precedence
+		if operation.Parameters.GetByInAndName(openapi3.ParameterInPath, path_param.Name) != nil {
+			continue
+		}
+		parameter := NewPathParameterExtended(path_param.Name, newFiberPathParamSchema(path_param))
+		operation.AddParameter(parameter.Value)
+	}
 }
 
 func generateIndexPage(ui_config SwaggerUIConfig) (index_page []byte, err error) {
-	index_tpl, err := template.New("swagger_index.html").Parse(indexPageTmpl)
-	if err != nil {
-		return nil, errors.Join(errors.New("gofiber-swagger: error while parsing the swagger index template -> "), err)
-	}
-	index_tpl_buf := bytes.NewBufferString("")
-	err = index_tpl.Execute(index_tpl_buf, ui_config)
-	if err != nil {
-		return nil, errors.Join(errors.New("gofiber-swagger: error while executing the swagger index template -> "), err)
-	}
-	return index_tpl_buf.Bytes(), nil
+	return renderTemplate("swagger_index.html", indexPageTmpl, ui_config)
 }
 
+// serializes the document with the top level fields ordered as listed by the specification,
+// converting the schemas into their 3.1 form for documents declaring 3.1 (see convertDocumentTo31)
 func generateOpenApiSchema(schema openapi3.T) (as_json, as_yaml []byte, err error) {
-	schema_as_yaml_raw, err := schema.MarshalYAML()
+	schema_as_json_raw, err := schema.MarshalJSON()
 	if err != nil {
-		return nil, nil, errors.Join(errors.New("gofiber-swagger: error while creating the yaml schema -> "), err)
+		return nil, nil, errors.Join(errors.New("gofiber-swagger: error while creating the json schema -> "), err)
 	}
-	schema_as_yaml, err := yaml.Marshal(schema_as_yaml_raw)
+	document, err := decodeJSONDocument(schema_as_json_raw)
 	if err != nil {
-		return nil, nil, errors.Join(errors.New("gofiber-swagger: error while converting the yaml schema to yaml -> "), err)
+		return nil, nil, errors.Join(errors.New("gofiber-swagger: error while reading the json schema -> "), err)
+	}
+	if isOpenAPI31(schema.OpenAPI) {
+		convertDocumentTo31(document)
 	}
+	document.sortKeys(documentFieldsOrder)
 
-	schema_as_json, err := schema.MarshalJSON()
+	schema_as_json, err := json.Marshal(document)
 	if err != nil {
 		return nil, nil, errors.Join(errors.New("gofiber-swagger: error while creating the json schema -> "), err)
 	}
+	schema_as_yaml, err := yaml.Marshal(document)
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("gofiber-swagger: error while converting the yaml schema to yaml -> "), err)
+	}
 
 	return schema_as_json, schema_as_yaml, nil
 }
 
-func createSwaggerFiles(target_folder_path string, index_page []byte, schema_as_json []byte, schema_as_yaml []byte) error {
+func createSwaggerFiles(config *Config, index_page []byte, schema_as_json []byte, schema_as_yaml []byte) error {
 	var creation_perms os.FileMode = 0o766
+	target_folder_path := config.SwaggerFilesPath
 
 	if err := os.MkdirAll(target_folder_path, creation_perms); err != nil {
 		return errors.Join(errors.New("unable to create file directory for swagger files"), err)
 	}
 
-	if err := os.WriteFile(filepath.Join(target_folder_path, "index.html"), index_page, creation_perms); err != nil {
-		return errors.Join(errors.New("unable to create index.html for swagger files"), err)
+	if err := os.WriteFile(filepath.Join(target_folder_path, config.IndexFileName), index_page, creation_perms); err != nil {
+		return errors.Join(errors.New("unable to create "+config.IndexFileName+" for swagger files"), err)
 	}
 
-	if err := os.WriteFile(filepath.Join(target_folder_path, "swagger.json"), schema_as_json, creation_perms); err != nil {
-		return errors.Join(errors.New("unable to create swagger.json for swagger files"), err)
+	if err := os.WriteFile(filepath.Join(target_folder_path, config.JSONFileName), schema_as_json, creation_perms); err != nil {
+		return errors.Join(errors.New("unable to create "+config.JSONFileName+" for swagger files"), err)
 	}
 
-	if err := os.WriteFile(filepath.Join(target_folder_path, "swagger.yaml"), schema_as_yaml, creation_perms); err != nil {
-		return errors.Join(errors.New("unable to create swagger.yaml for swagger files"), err)
+	if err := os.WriteFile(filepath.Join(target_folder_path, config.YAMLFileName), schema_as_yaml, creation_perms); err != nil {
+		return errors.Join(errors.New("unable to create "+config.YAMLFileName+" for swagger files"), err)
 	}
 
 	return nil
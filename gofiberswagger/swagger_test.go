@@ -2,6 +2,7 @@ package gofiberswagger
 
 import (
 	"fmt"
+	"io"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
@@ -86,8 +87,10 @@ func TestCreateSwaggerFiles(t *testing.T) {
 	index_page := []byte("index")
 	schema_as_json := []byte("json")
 	schema_as_yaml := []byte("yaml")
+	config := &Config{SwaggerFilesPath: temp_dir}
+	configDefault(config)
 
-	err := createSwaggerFiles(temp_dir, index_page, schema_as_json, schema_as_yaml)
+	err := createSwaggerFiles(config, index_page, schema_as_json, schema_as_yaml)
 	assert.NoError(t, err, "Error while creating the swagger files")
 
 	assert.FileExists(t, filepath.Join(temp_dir, "index.html"))
@@ -116,7 +119,7 @@ func TestRegister(t *testing.T) {
 		app := fiber.New()
 
 		// register swagger
-		err := Register(app, Config{})
+		err := Register(app, &Config{})
 		assert.NoError(t, err, "Error while registering swagger")
 
 		// test swagger routes
@@ -125,6 +128,150 @@ func TestRegister(t *testing.T) {
 		assert.Equal(t, 200, resp.StatusCode, "The swagger route should return 200")
 	})
 
+	t.Run("should serve swagger files on custom base path", func(t *testing.T) {
+		t.Parallel()
+		// fiber instance
+		app := fiber.New()
+
+		// register swagger
+		config := &Config{
+			BasePath:      "/internal/docs/",
+			IndexFileName: "docs.html",
+			JSONFileName:  "openapi.json",
+			YAMLFileName:  "openapi.yaml",
+		}
+		err := Register(app, config)
+		assert.NoError(t, err, "Error while registering swagger")
+		assert.Equal(t, "/internal/docs", config.BasePath)
+		assert.Equal(t, "/internal/docs/openapi.yaml", config.SwaggerUI.URL)
+
+		// test swagger routes
+		for _, path := range []string{"/internal/docs", "/internal/docs/docs.html", "/internal/docs/openapi.json", "/internal/docs/openapi.yaml"} {
+			resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+			assert.NoError(t, err, "Error while testing the swagger route")
+			assert.Equal(t, 200, resp.StatusCode, "The swagger route "+path+" should return 200")
+		}
+		for _, path := range []string{"/swagger", "/swagger/swagger.json", "/internal/docs/swagger.yaml"} {
+			resp, err := app.Test(httptest.NewRequest("GET", path, nil))
+			assert.NoError(t, err, "Error while testing the swagger route")
+			assert.Equal(t, 404, resp.StatusCode, "The swagger route "+path+" should not exist")
+		}
+	})
+
+	t.Run("should redirect the deprecated /swagger/swagger route to the docs", func(t *testing.T) {
+		t.Parallel()
+		app := fiber.New()
+		err := Register(app, &Config{})
+		assert.NoError(t, err, "Error while registering swagger")
+		resp, err := app.Test(httptest.NewRequest("GET", "/swagger/swagger", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 301, resp.StatusCode)
+		assert.Equal(t, "/swagger/", resp.Header.Get("Location"))
+
+		// configured docs take precedence
+		app = fiber.New()
+		err = Register(app, &Config{IndexFileName: "swagger"})
+		assert.NoError(t, err, "Error while registering swagger")
+		resp, err = app.Test(httptest.NewRequest("GET", "/swagger/swagger", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+
+		// docs moved elsewhere leave the path to the app
+		app = fiber.New()
+		app.Get("/swagger/swagger", func(c fiber.Ctx) error {
+			return c.SendString("app")
+		})
+		err = Register(app, &Config{BasePath: "/internal/docs"})
+		assert.NoError(t, err, "Error while registering swagger")
+		resp, err = app.Test(httptest.NewRequest("GET", "/swagger/swagger", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		matching := 0
+		for _, route := range app.GetRoutes(true) {
+			if route.Path == "/swagger/swagger" {
+				matching++
+			}
+		}
+		assert.Equal(t, 2, matching) // GET & HEAD of the app
+	})
+
+	t.Run("should serve swagger files on a separate router", func(t *testing.T) {
+		t.Parallel()
+		public_app := fiber.New()
+		public_app.Get("/public", func(c fiber.Ctx) error {
+			return c.SendString("public")
+		})
+		admin_app := fiber.New()
+		admin_group := admin_app.Group("/admin")
+
+		config := &Config{
+			DocsRouter: admin_group,
+			DocsMiddleware: []any{func(c fiber.Ctx) error {
+				if c.Get("Authorization") != "secret" {
+					return c.SendStatus(401)
+				}
+				return c.Next()
+			}},
+		}
+		err := Register(public_app, config)
+		assert.NoError(t, err, "Error while registering swagger")
+		assert.Equal(t, "/admin/swagger/swagger.yaml", config.SwaggerUI.URL)
+		assert.NotNil(t, config.Swagger.Paths.Find("/public"))
+
+		// docs are not part of the documented app
+		resp, err := public_app.Test(httptest.NewRequest("GET", "/swagger/swagger.json", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 404, resp.StatusCode)
+		for _, route := range public_app.GetRoutes(true) {
+			assert.NotContains(t, route.Path, "/swagger")
+		}
+
+		// docs are served by the admin app & protected by the middleware
+		resp, err = admin_app.Test(httptest.NewRequest("GET", "/admin/swagger/swagger.json", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 401, resp.StatusCode)
+		req := httptest.NewRequest("GET", "/admin/swagger/swagger.json", nil)
+		req.Header.Set("Authorization", "secret")
+		resp, err = admin_app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+	})
+
+	t.Run("should only generate handlers", func(t *testing.T) {
+		t.Parallel()
+		app := fiber.New()
+		app.Get("/test", func(c fiber.Ctx) error {
+			return c.SendString("test")
+		})
+
+		handlers, err := GenerateHandlers(app, &Config{})
+		assert.NoError(t, err, "Error while generating swagger handlers")
+		assert.NotNil(t, handlers.Index)
+		assert.NotNil(t, handlers.JSON)
+		assert.NotNil(t, handlers.YAML)
+		assert.Len(t, app.GetRoutes(true), 1)
+
+		app.Get("/openapi.json", handlers.JSON)
+		resp, err := app.Test(httptest.NewRequest("GET", "/openapi.json", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), "\"/test\"")
+	})
+
+	t.Run("should keep explicitly set ui url", func(t *testing.T) {
+		t.Parallel()
+		app := fiber.New()
+		config := &Config{
+			BasePath:  "/docs",
+			SwaggerUI: SwaggerUIConfig{URL: "/gateway/docs/swagger.yaml"},
+		}
+		err := Register(app, config)
+		assert.NoError(t, err, "Error while registering swagger")
+		assert.Equal(t, "/gateway/docs/swagger.yaml", config.SwaggerUI.URL)
+	})
+
 	t.Run("should create swagger files if enabled", func(t *testing.T) {
 		t.Parallel()
 
@@ -133,7 +280,7 @@ func TestRegister(t *testing.T) {
 		tempDir := t.TempDir()
 
 		// register swagger with file creation enabled
-		err := Register(app, Config{
+		err := Register(app, &Config{
 			CreateSwaggerFiles: true,
 			SwaggerFilesPath:   tempDir,
 		})
@@ -155,7 +302,7 @@ func TestRegister(t *testing.T) {
 		})
 
 		// register swagger with method to tags enabled
-		err := Register(app, Config{
+		err := Register(app, &Config{
 			AppendMethodToTags: true,
 		})
 		assert.NoError(t, err, "Error while registering swagger")
@@ -171,7 +318,7 @@ func TestRegister(t *testing.T) {
 		})
 
 		// register swagger with auth required
-		err := Register(app, Config{
+		err := Register(app, &Config{
 			AutomaticallyRequireAuth: true,
 			RequiredAuth: &openapi3.SecurityRequirements{
 				{
@@ -192,7 +339,7 @@ func TestRegister(t *testing.T) {
 		})
 
 		// register swagger
-		err := Register(app, Config{})
+		err := Register(app, &Config{})
 		assert.NoError(t, err, "Error while registering swagger")
 	})
 
@@ -206,7 +353,66 @@ func TestRegister(t *testing.T) {
 		})
 
 		// register swagger
-		err := Register(app, Config{})
+		err := Register(app, &Config{})
 		assert.NoError(t, err, "Error while registering swagger")
 	})
 }
+
+func TestRegister_TypedPathParameters(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	handler := func(c fiber.Ctx) error { return c.SendStatus(200) }
+	router.Get("/users/:id<int>", nil, handler)
+	router.Get("/items/:id<guid>", &RouteInfo{
+		// explicitly declared parameters take precedence
+		Parameters: NewParameters(NewPathParameterExtended("id", &Schema{Type: &Types{"string"}, Description: "custom"})),
+	}, handler)
+
+	config := &Config{}
+	err := registry.register(app, config)
+	assert.NoError(t, err)
+
+	users := config.Swagger.Paths.Find("/users/{id}")
+	assert.NotNil(t, users)
+	assert.Len(t, users.Get.Parameters, 1)
+	assert.Equal(t, "integer", (*users.Get.Parameters[0].Value.Schema.Value.Type)[0])
+
+	items := config.Swagger.Paths.Find("/items/{id}")
+	assert.NotNil(t, items)
+	assert.Len(t, items.Get.Parameters, 1)
+	assert.Equal(t, "custom", items.Get.Parameters[0].Value.Schema.Value.Description)
+}
+
+func TestRegister_OptionalPathParameters(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	router := registry.NewRouter(app)
+	handler := func(c fiber.Ctx) error { return c.SendStatus(200) }
+	router.Get("/users/:name?", &RouteInfo{
+		Parameters: NewParameters(NewPathParameter("name"), NewQueryParameter("q")),
+	}, handler)
+	router.Get("/files/*", nil, handler)
+
+	config := &Config{}
+	err := registry.register(app, config)
+	assert.NoError(t, err)
+
+	with_name := config.Swagger.Paths.Find("/users/{name}")
+	assert.NotNil(t, with_name)
+	assert.Len(t, with_name.Get.Parameters, 2)
+	assert.True(t, with_name.Get.Parameters.GetByInAndName(openapi3.ParameterInPath, "name").Required)
+
+	without_name := config.Swagger.Paths.Find("/users")
+	assert.NotNil(t, without_name)
+	assert.Len(t, without_name.Get.Parameters, 1)
+	assert.Equal(t, "q", without_name.Get.Parameters[0].Value.Name)
+
+	assert.NotNil(t, config.Swagger.Paths.Find("/files/{wildcard}"))
+	assert.NotNil(t, config.Swagger.Paths.Find("/files"))
+	assert.Nil(t, config.Swagger.Paths.Find("/files/*1"))
+}
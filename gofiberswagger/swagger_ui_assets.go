@@ -0,0 +1,59 @@
+package gofiberswagger
+
+import (
+	"embed"
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type SwaggerUIAssetsSource string
+
+const (
+	// Load the swagger ui assets from a CDN (requires internet access from the browser)
+	AssetsSourceCDN SwaggerUIAssetsSource = "cdn"
+	// Serve the swagger ui assets from SwaggerUIConfig.AssetsFS (works offline / with strict CSP)
+	AssetsSourceEmbedded SwaggerUIAssetsSource = "embedded"
+)
+
+//go:embed swagger-ui-dist
+var embeddedSwaggerUIDist embed.FS
+
+// EmbeddedSwaggerUIAssets contains the swagger-ui-dist files shipped with the library.
+// See swagger-ui-dist/README.md for how to update them.
+var EmbeddedSwaggerUIAssets, _ = fs.Sub(embeddedSwaggerUIDist, "swagger-ui-dist")
+
+// files required by the index page template
+var swaggerUIAssetFiles = []string{
+	"swagger-ui.css",
+	"swagger-ui-bundle.js",
+	"swagger-ui-standalone-preset.js",
+	"favicon-32x32.png",
+	"favicon-16x16.png",
+}
+
+func validateSwaggerUIAssets(assets fs.FS) error {
+	if assets == nil {
+		return errors.New("gofiber-swagger: AssetsSource was set to embedded, however AssetsFS is nil")
+	}
+	for _, file := range swaggerUIAssetFiles {
+		if _, err := fs.Stat(assets, file); err != nil {
+			return errors.Join(errors.New("gofiber-swagger: swagger ui asset \""+file+"\" is missing from AssetsFS (run `make update-swagger-ui` to download the embedded assets)"), err)
+		}
+	}
+	return nil
+}
+
+func newSwaggerUIAssetsHandler(assets fs.FS) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		file := path.Base(c.Path())
+		content, err := fs.ReadFile(assets, file)
+		if err != nil {
+			return fiber.ErrNotFound
+		}
+		return c.Type(strings.TrimPrefix(path.Ext(file), ".")).Send(content)
+	}
+}
@@ -0,0 +1,112 @@
+package gofiberswagger
+
+import (
+	"io"
+	"io/fs"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSwaggerUIAssets() fstest.MapFS {
+	assets := fstest.MapFS{}
+	for _, file := range swaggerUIAssetFiles {
+		assets[file] = &fstest.MapFile{Data: []byte("/* " + file + " */")}
+	}
+	return assets
+}
+
+func TestSwaggerUIAssets_CDN(t *testing.T) {
+	t.Parallel()
+
+	cfg := swaggerUIConfigDefault(SwaggerUIConfig{Version: "5.0.0"})
+	index, err := generateIndexPage(cfg)
+	assert.NoError(t, err)
+	assert.Contains(t, string(index), "https://unpkg.com/swagger-ui-dist@5.0.0/swagger-ui-bundle.js")
+	assert.Contains(t, string(index), "https://unpkg.com/swagger-ui-dist@5.0.0/swagger-ui.css")
+}
+
+func TestSwaggerUIAssets_Embedded(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should serve the assets next to the docs", func(t *testing.T) {
+		t.Parallel()
+		app := fiber.New()
+		err := Register(app, &Config{
+			BasePath: "/docs",
+			SwaggerUI: SwaggerUIConfig{
+				AssetsSource: AssetsSourceEmbedded,
+				AssetsFS:     newTestSwaggerUIAssets(),
+			},
+		})
+		assert.NoError(t, err)
+
+		resp, err := app.Test(httptest.NewRequest("GET", "/docs/", nil))
+		assert.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), "/docs/swagger-ui-bundle.js")
+		assert.Contains(t, string(body), "/docs/favicon-32x32.png")
+		assert.NotContains(t, string(body), "unpkg.com")
+		assert.NotContains(t, string(body), "fonts.googleapis.com")
+
+		resp, err = app.Test(httptest.NewRequest("GET", "/docs/swagger-ui-bundle.js", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "javascript")
+		body, err = io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "/* swagger-ui-bundle.js */", string(body))
+
+		resp, err = app.Test(httptest.NewRequest("GET", "/docs/swagger-ui.css", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "text/css")
+	})
+
+	t.Run("should serve the assets embedded in the library", func(t *testing.T) {
+		t.Parallel()
+		app := fiber.New()
+		config := &Config{SwaggerUI: SwaggerUIConfig{AssetsSource: AssetsSourceEmbedded}}
+		err := Register(app, config)
+		assert.NoError(t, err)
+		assert.Equal(t, EmbeddedSwaggerUIAssets, config.SwaggerUI.AssetsFS)
+
+		for _, file := range swaggerUIAssetFiles {
+			expected, err := fs.ReadFile(EmbeddedSwaggerUIAssets, file)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, expected)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/swagger/"+file, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, 200, resp.StatusCode, file)
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, body, file)
+		}
+
+		// the embedded assets match the pinned version
+		version, err := fs.ReadFile(EmbeddedSwaggerUIAssets, "VERSION")
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultUIConfig.Version, strings.TrimSpace(string(version)))
+		bundle, err := fs.ReadFile(EmbeddedSwaggerUIAssets, "swagger-ui-bundle.js")
+		assert.NoError(t, err)
+		assert.Contains(t, string(bundle), `PACKAGE_VERSION:"`+DefaultUIConfig.Version+`"`)
+	})
+
+	t.Run("should fail when assets are missing", func(t *testing.T) {
+		t.Parallel()
+		app := fiber.New()
+		err := Register(app, &Config{
+			SwaggerUI: SwaggerUIConfig{
+				AssetsSource: AssetsSourceEmbedded,
+				AssetsFS:     fstest.MapFS{},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
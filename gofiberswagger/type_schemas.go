@@ -0,0 +1,88 @@
+package gofiberswagger
+
+import (
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Schemas of types which should not be generated by reflection                  ///
+/// (custom ID types, decimals, stdlib types with custom (un)marshalling, ...)    ///
+/// ---------------------------------------------------------------------------- ///
+
+// built-in mappings of the stdlib types, which serialize differently than their structure suggests
+var builtinTypeSchemas = map[reflect.Type]func() *Schema{
+	reflect.TypeOf(time.Duration(0)): func() *Schema {
+		return &Schema{Type: &Types{"integer"}, Format: "int64", Description: "duration in nanoseconds"}
+	},
+	reflect.TypeOf(json.Number("")): func() *Schema {
+		return &Schema{Type: &Types{"number"}}
+	},
+	reflect.TypeOf(big.Int{}): func() *Schema {
+		return &Schema{Type: &Types{"integer"}}
+	},
+	reflect.TypeOf(big.Float{}): func() *Schema {
+		return &Schema{Type: &Types{"string"}, Format: "decimal"}
+	},
+	reflect.TypeOf(big.Rat{}): func() *Schema {
+		return &Schema{Type: &Types{"string"}, Example: "1/3"}
+	},
+	reflect.TypeOf(netip.Addr{}): func() *Schema {
+		return &Schema{Type: &Types{"string"}, Format: "ip"}
+	},
+	reflect.TypeOf(netip.AddrPort{}): func() *Schema {
+		return &Schema{Type: &Types{"string"}, Example: "127.0.0.1:8080"}
+	},
+	reflect.TypeOf(netip.Prefix{}): func() *Schema {
+		return &Schema{Type: &Types{"string"}, Format: "cidr"}
+	},
+	reflect.TypeOf(net.IP{}): func() *Schema {
+		return &Schema{Type: &Types{"string"}, Format: "ip"}
+	},
+	reflect.TypeOf(url.URL{}): func() *Schema {
+		return &Schema{Type: &Types{"string"}, Format: "uri"}
+	},
+}
+
+// RegisterTypeSchema overrides the schema generated for T (and *T) inside the DefaultRegistry.
+// The function gets called for every usage of the type, so it should return a new schema every time.
+//
+//	gofiberswagger.RegisterTypeSchema[decimal.Decimal](func() *gofiberswagger.Schema {
+//		return &gofiberswagger.Schema{Type: &gofiberswagger.Types{"string"}, Format: "decimal"}
+//	})
+func RegisterTypeSchema[T any](schema func() *Schema) {
+	RegisterTypeSchemaIn[T](DefaultRegistry, schema)
+}
+
+// RegisterTypeSchemaIn works the same way as RegisterTypeSchema, but registers the schema inside the provided registry
+func RegisterTypeSchemaIn[T any](registry *Registry, schema func() *Schema) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	registry.schemasMutex.Lock()
+	defer registry.schemasMutex.Unlock()
+	registry.typeSchemas[t] = schema
+}
+
+// returns the schema registered for t (using RegisterTypeSchema or a built-in one), or nil.
+// call only while holding r.schemasMutex!
+func (r *Registry) getRegisteredTypeSchema(t reflect.Type) *SchemaRef {
+	schema_func, ok := r.typeSchemas[t]
+	if !ok {
+		schema_func, ok = builtinTypeSchemas[t]
+	}
+	if !ok {
+		return nil
+	}
+	if schema := schema_func(); schema != nil {
+		return &SchemaRef{Value: schema}
+	}
+	return nil
+}
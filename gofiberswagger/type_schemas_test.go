@@ -0,0 +1,70 @@
+package gofiberswagger
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type TypeSchemasUserID int64
+
+type TypeSchemasDecimal struct {
+	value int64
+	exp   int32
+}
+
+type TypeSchemasPayment struct {
+	ID       TypeSchemasUserID   `json:"id"`
+	Amount   TypeSchemasDecimal  `json:"amount"`
+	Fee      *TypeSchemasDecimal `json:"fee"`
+	Timeout  time.Duration       `json:"timeout"`
+	Balance  *big.Int            `json:"balance"`
+	Number   json.Number         `json:"number"`
+	Address  netip.Addr          `json:"address"`
+	Callback url.URL             `json:"callback"`
+}
+
+func TestRegisterTypeSchema(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	RegisterTypeSchemaIn[TypeSchemasUserID](registry, func() *Schema {
+		return &Schema{Type: &Types{"string"}, Format: "user-id"}
+	})
+	RegisterTypeSchemaIn[*TypeSchemasDecimal](registry, func() *Schema {
+		return &Schema{Type: &Types{"string"}, Format: "decimal"}
+	})
+
+	schema := CreateSchemaIn[TypeSchemasPayment](registry)
+	properties := schema.Value.Properties
+
+	assert.Equal(t, "user-id", properties["id"].Value.Format)
+	assert.Equal(t, "decimal", properties["amount"].Value.Format)
+	assert.Equal(t, "string", (*properties["amount"].Value.Type)[0])
+	assert.Equal(t, "decimal", properties["fee"].Value.Format)
+	assert.True(t, properties["fee"].Value.Nullable)
+
+	// built-in mappings
+	assert.Equal(t, "integer", (*properties["timeout"].Value.Type)[0])
+	assert.Equal(t, "integer", (*properties["balance"].Value.Type)[0])
+	assert.Equal(t, "number", (*properties["number"].Value.Type)[0])
+	assert.Equal(t, "ip", properties["address"].Value.Format)
+	assert.Equal(t, "uri", properties["callback"].Value.Format)
+
+	// top level usage
+	assert.Equal(t, "decimal", CreateSchemaIn[TypeSchemasDecimal](registry).Value.Format)
+	assert.Equal(t, "user-id", CreateSchemaIn[[]TypeSchemasUserID](registry).Value.Items.Value.Format)
+
+	// the mapped types don't become components & the field specific info doesn't leak between usages
+	assert.Len(t, registry.getAcquiredSchemas(), 1)
+	assert.Empty(t, CreateSchemaIn[TypeSchemasDecimal](registry).Value.Title)
+
+	// other registries are not affected
+	other := CreateSchemaIn[TypeSchemasPayment](NewRegistry(nil))
+	assert.Equal(t, "integer", (*other.Value.Properties["id"].Value.Type)[0])
+}
@@ -2,9 +2,10 @@ package gofiberswagger
 
 import (
 	"encoding/json"
+	"maps"
 	"math"
 	"reflect"
-	"strings"
+	"slices"
 	"time"
 )
 
@@ -38,11 +39,7 @@ var (
 	maxFloat64 = float64(math.MaxFloat64)
 )
 
-func replaceNthOccurrence(s, old, new string, n int) string {
-	parts := strings.Split(s, old)
-	if n <= 0 || n >= len(parts) {
-		return s
-	}
-	result := strings.Join(parts[:n], old) + new + strings.Join(parts[n:], old)
-	return result
+// returns the keys of the map in a deterministic (sorted) order
+func sortedKeys[M ~map[string]V, V any](m M) []string {
+	return slices.Sorted(maps.Keys(m))
 }
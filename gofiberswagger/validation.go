@@ -0,0 +1,142 @@
+package gofiberswagger
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gofiber/fiber/v3"
+)
+
+/// ---------------------------------------------------------------------------- ///
+/// Shared parts of the request / response validation middlewares                ///
+/// ---------------------------------------------------------------------------- ///
+
+// ProblemDetails is the RFC 7807 (https://www.rfc-editor.org/rfc/rfc7807) body sent by the validation middlewares
+type ProblemDetails struct {
+	Type     string   `json:"type"`
+	Title    string   `json:"title"`
+	Status   int      `json:"status"`
+	Detail   string   `json:"detail,omitempty"`
+	Instance string   `json:"instance,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+const problemDetailsContentType = "application/problem+json"
+
+func newProblemDetails(c fiber.Ctx, status int, detail string, err error) ProblemDetails {
+	return ProblemDetails{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Path(),
+		Errors:   unpackValidationErrors(err),
+	}
+}
+
+func sendProblemDetails(c fiber.Ctx, problem ProblemDetails) error {
+	return c.Status(problem.Status).JSON(problem, problemDetailsContentType)
+}
+
+// flattens the errors returned by openapi3filter (when Options.MultiError is set) into messages
+func unpackValidationErrors(err error) []string {
+	if err == nil {
+		return nil
+	}
+	var multi_error openapi3.MultiError
+	if !errors.As(err, &multi_error) {
+		return []string{err.Error()}
+	}
+	messages := []string{}
+	for _, e := range multi_error {
+		messages = append(messages, unpackValidationErrors(e)...)
+	}
+	return messages
+}
+
+// route of the generated document, matched against the incoming requests
+type validationRoute struct {
+	pattern     *regexp.Regexp
+	param_names []string
+	route       *routers.Route
+}
+
+// compiles the path variant into a regexp which matches the same paths as fiber
+func newValidationRoute(variant fiberPathVariant, case_sensitive bool, strict_routing bool) *validationRoute {
+	pattern := strings.Builder{}
+	if !case_sensitive {
+		pattern.WriteString("(?i)")
+	}
+	pattern.WriteString("^")
+
+	param_names := []string{}
+	rest := variant.Path
+	for _, param := range variant.Params {
+		placeholder := "{" + param.Name + "}"
+		index := strings.Index(rest, placeholder)
+		if index == -1 {
+			continue
+		}
+		pattern.WriteString(regexp.QuoteMeta(rest[:index]))
+		if param.Greedy {
+			pattern.WriteString("(.*)")
+		} else {
+			pattern.WriteString("([^/]+?)")
+		}
+		param_names = append(param_names, param.Name)
+		rest = rest[index+len(placeholder):]
+	}
+	rest_pattern := regexp.QuoteMeta(rest)
+	if !strict_routing {
+		rest_pattern = regexp.QuoteMeta(strings.TrimSuffix(rest, "/")) + "/?"
+	}
+	pattern.WriteString(rest_pattern)
+	pattern.WriteString("$")
+
+	return &validationRoute{
+		pattern:     regexp.MustCompile(pattern.String()),
+		param_names: param_names,
+	}
+}
+
+func (r *Registry) setValidationRoutes(routes []*validationRoute) {
+	r.validationMutex.Lock()
+	defer r.validationMutex.Unlock()
+
+	r.validationRoutes = routes
+}
+
+// finds the documented operation of the request, returns nil if the request isn't documented (or Register wasn't called yet)
+func (r *Registry) findValidationRoute(method string, path string) (*routers.Route, map[string]string) {
+	r.validationMutex.RLock()
+	defer r.validationMutex.RUnlock()
+
+	for _, validation_route := range r.validationRoutes {
+		if validation_route.route.Method != method {
+			continue
+		}
+		matches := validation_route.pattern.FindStringSubmatch(path)
+		if matches == nil {
+			continue
+		}
+		path_params := make(map[string]string, len(validation_route.param_names))
+		for i, name := range validation_route.param_names {
+			path_params[name] = matches[i+1]
+		}
+		return validation_route.route, path_params
+	}
+	return nil, nil
+}
+
+// checks the x-skip-*-validation extension of the operation
+func isValidationSkipped(operation *openapi3.Operation, extension string) bool {
+	if operation == nil || operation.Extensions == nil {
+		return false
+	}
+	skip, ok := operation.Extensions[extension].(bool)
+	return ok && skip
+}
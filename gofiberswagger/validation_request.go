@@ -0,0 +1,129 @@
+package gofiberswagger
+
+import (
+	"errors"
+	"log"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/adaptor"
+)
+
+// ExtensionSkipRequestValidation opts the route out of the request validation
+//
+//	router.Post("/webhook", &gofiberswagger.RouteInfo{
+//		Extensions: map[string]any{gofiberswagger.ExtensionSkipRequestValidation: true},
+//	}, WebhookHandler)
+const ExtensionSkipRequestValidation = "x-skip-request-validation"
+
+// RequestValidationConfig stores the request validation middleware configuration variables
+type RequestValidationConfig struct {
+	// Registry, whose generated document is used for the validation.
+	// Requests are not validated until the registry's documentation gets generated (Register / GenerateHandlers).
+	// default: DefaultRegistry
+	Registry *Registry
+
+	// Next defines a function to skip this middleware when returned true.
+	// default: nil
+	Next func(c fiber.Ctx) bool
+
+	// Only reports the invalid requests (using OnError), without rejecting them.
+	// default: false
+	ReportOnly bool
+
+	// Called for every invalid request.
+	// default: nil -> logs the error in the ReportOnly mode
+	OnError func(c fiber.Ctx, err error)
+
+	// Creates the response for invalid requests.
+	// default: RFC 7807 problem details (400 Bad Request / 401 Unauthorized)
+	ErrorHandler func(c fiber.Ctx, err error) error
+
+	// Options passed to openapi3filter.ValidateRequest.
+	// Request defaults are not set, since the generated schemas contain zero value defaults, which would satisfy every required property.
+	// default: &openapi3filter.Options{MultiError: true, SkipSettingDefaults: true, AuthenticationFunc: openapi3filter.NoopAuthenticationFunc}
+	Options *openapi3filter.Options
+}
+
+var DefaultRequestValidationConfig = RequestValidationConfig{
+	Options: &openapi3filter.Options{
+		MultiError:          true,
+		SkipSettingDefaults: true,
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+	},
+}
+
+func requestValidationConfigDefault(config ...RequestValidationConfig) RequestValidationConfig {
+	if len(config) < 1 {
+		config = []RequestValidationConfig{DefaultRequestValidationConfig}
+	}
+	cfg := config[0]
+
+	if cfg.Registry == nil {
+		cfg.Registry = DefaultRegistry
+	}
+
+	if cfg.ErrorHandler == nil {
+		cfg.ErrorHandler = defaultRequestValidationErrorHandler
+	}
+
+	if cfg.OnError == nil && cfg.ReportOnly {
+		cfg.OnError = func(c fiber.Ctx, err error) {
+			log.Println("gofiber-swagger: invalid request", c.Method(), c.Path(), ":", err)
+		}
+	}
+
+	if cfg.Options == nil {
+		cfg.Options = DefaultRequestValidationConfig.Options
+	}
+
+	return cfg
+}
+
+func defaultRequestValidationErrorHandler(c fiber.Ctx, err error) error {
+	var security_err *openapi3filter.SecurityRequirementsError
+	if errors.As(err, &security_err) {
+		return sendProblemDetails(c, newProblemDetails(c, fiber.StatusUnauthorized, "security requirements failed", err))
+	}
+	return sendProblemDetails(c, newProblemDetails(c, fiber.StatusBadRequest, "request doesn't match the documentation", err))
+}
+
+// NewRequestValidator creates a middleware, which validates the path / query / header / cookie parameters
+// and request bodies of documented operations against the generated document.
+// Requests of undocumented routes are passed through.
+func NewRequestValidator(config ...RequestValidationConfig) fiber.Handler {
+	cfg := requestValidationConfigDefault(config...)
+
+	return func(c fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		route, path_params := cfg.Registry.findValidationRoute(c.Method(), c.Path())
+		if route == nil || isValidationSkipped(route.Operation, ExtensionSkipRequestValidation) {
+			return c.Next()
+		}
+
+		request, err := adaptor.ConvertRequest(c, false)
+		if err != nil {
+			return err
+		}
+		err = openapi3filter.ValidateRequest(c.Context(), &openapi3filter.RequestValidationInput{
+			Request:    request,
+			PathParams: path_params,
+			Route:      route,
+			Options:    cfg.Options,
+		})
+		if err == nil {
+			return c.Next()
+		}
+
+		if cfg.OnError != nil {
+			cfg.OnError(c, err)
+		}
+		if cfg.ReportOnly {
+			return c.Next()
+		}
+		return cfg.ErrorHandler(c, err)
+	}
+}
@@ -0,0 +1,167 @@
+package gofiberswagger
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type ValidatedRequestBody struct {
+	Name string `json:"name" validate:"required"`
+	Age  int    `json:"age" validate:"min=0,max=150"`
+}
+
+func newRequestValidationTestApp(t *testing.T, config RequestValidationConfig) *fiber.App {
+	t.Helper()
+
+	registry := NewRegistry(nil)
+	config.Registry = registry
+	app := fiber.New()
+	app.Use(NewRequestValidator(config))
+
+	handler := func(c fiber.Ctx) error { return c.SendStatus(200) }
+	router := registry.NewRouter(app)
+	router.Get("/users/:id<int>", &RouteInfo{
+		Parameters: NewParameters(NewQueryParameterRequired("fields")),
+	}, handler)
+	router.Post("/users", &RouteInfo{
+		RequestBody: NewRequestBody[ValidatedRequestBody](),
+	}, handler)
+	router.Get("/files/:name?", nil, handler)
+	router.Post("/webhook", &RouteInfo{
+		RequestBody: NewRequestBody[ValidatedRequestBody](),
+		Extensions:  map[string]any{ExtensionSkipRequestValidation: true},
+	}, handler)
+	app.Get("/undocumented/:id<int>", handler)
+
+	assert.NoError(t, registry.register(app, &Config{}))
+	return app
+}
+
+func doValidationRequest(t *testing.T, app *fiber.App, method string, target string, body string) *http.Response {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestNewRequestValidator(t *testing.T) {
+	t.Parallel()
+
+	app := newRequestValidationTestApp(t, RequestValidationConfig{})
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedStatus int
+	}{
+		{"valid parameters", "GET", "/users/1?fields=name", "", 200},
+		{"invalid path parameter", "GET", "/users/abc?fields=name", "", 400},
+		{"missing required query parameter", "GET", "/users/1", "", 400},
+		{"valid body", "POST", "/users", `{"name":"john","age":20}`, 200},
+		{"missing required property", "POST", "/users", `{"age":20}`, 400},
+		{"invalid property", "POST", "/users", `{"name":"john","age":200}`, 400},
+		{"malformed body", "POST", "/users", `{"name":`, 400},
+		{"optional parameter present", "GET", "/files/a.txt", "", 200},
+		{"optional parameter omitted", "GET", "/files", "", 200},
+		{"skipped route", "POST", "/webhook", `{"age":200}`, 200},
+		{"undocumented route", "GET", "/undocumented/1", "", 200},
+		{"trailing slash", "GET", "/users/1/?fields=name", "", 200},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := doValidationRequest(t, app, tc.method, tc.target, tc.body)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestNewRequestValidator_ProblemDetails(t *testing.T) {
+	t.Parallel()
+
+	app := newRequestValidationTestApp(t, RequestValidationConfig{})
+	resp := doValidationRequest(t, app, "POST", "/users", `{"age":200}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, problemDetailsContentType, resp.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var problem ProblemDetails
+	assert.NoError(t, json.Unmarshal(body, &problem))
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, "Bad Request", problem.Title)
+	assert.Equal(t, "/users", problem.Instance)
+	// both the missing "name" and the invalid "age" get reported
+	assert.Len(t, problem.Errors, 2)
+}
+
+func TestNewRequestValidator_ReportOnly(t *testing.T) {
+	t.Parallel()
+
+	reported := atomic.Int32{}
+	app := newRequestValidationTestApp(t, RequestValidationConfig{
+		ReportOnly: true,
+		OnError: func(c fiber.Ctx, err error) {
+			reported.Add(1)
+		},
+	})
+
+	resp := doValidationRequest(t, app, "POST", "/users", `{"age":200}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(1), reported.Load())
+}
+
+func TestNewRequestValidator_CustomErrorHandlerAndNext(t *testing.T) {
+	t.Parallel()
+
+	app := newRequestValidationTestApp(t, RequestValidationConfig{
+		Next: func(c fiber.Ctx) bool {
+			return c.Get("X-Skip-Validation") != ""
+		},
+		ErrorHandler: func(c fiber.Ctx, err error) error {
+			return c.Status(fiber.StatusUnprocessableEntity).SendString(err.Error())
+		},
+	})
+
+	resp := doValidationRequest(t, app, "POST", "/users", `{"age":200}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	req := httptest.NewRequest("POST", "/users", strings.NewReader(`{"age":200}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Skip-Validation", "1")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewRequestValidator_BeforeRegister(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(nil)
+	app := fiber.New()
+	app.Use(NewRequestValidator(RequestValidationConfig{Registry: registry}))
+	registry.NewRouter(app).Post("/users", &RouteInfo{
+		RequestBody: NewRequestBody[ValidatedRequestBody](),
+	}, func(c fiber.Ctx) error { return c.SendStatus(200) })
+
+	resp := doValidationRequest(t, app, "POST", "/users", `{"age":200}`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,127 @@
+package gofiberswagger
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/adaptor"
+)
+
+// ExtensionSkipResponseValidation opts the route out of the response validation
+//
+//	router.Get("/legacy", &gofiberswagger.RouteInfo{
+//		Extensions: map[string]any{gofiberswagger.ExtensionSkipResponseValidation: true},
+//	}, LegacyHandler)
+const ExtensionSkipResponseValidation = "x-skip-response-validation"
+
+// ResponseValidationConfig stores the response validation middleware configuration variables
+type ResponseValidationConfig struct {
+	// Registry, whose generated document is used for the validation.
+	// Responses are not validated until the registry's documentation gets generated (Register / GenerateHandlers).
+	// default: DefaultRegistry
+	Registry *Registry
+
+	// Next defines a function to skip this middleware when returned true.
+	// default: nil
+	Next func(c fiber.Ctx) bool
+
+	// Replaces invalid responses with a RFC 7807 problem response (500 Internal Server Error),
+	// useful in tests / CI. Otherwise the invalid responses are only reported using OnError.
+	// default: false
+	FailOnError bool
+
+	// Called for every invalid response.
+	// default: logs the error
+	OnError func(c fiber.Ctx, err error)
+
+	// Options passed to openapi3filter.ValidateResponse.
+	// default: &openapi3filter.Options{MultiError: true, IncludeResponseStatus: true, AuthenticationFunc: openapi3filter.NoopAuthenticationFunc}
+	Options *openapi3filter.Options
+}
+
+var DefaultResponseValidationConfig = ResponseValidationConfig{
+	Options: &openapi3filter.Options{
+		MultiError:            true,
+		IncludeResponseStatus: true,
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+	},
+}
+
+func responseValidationConfigDefault(config ...ResponseValidationConfig) ResponseValidationConfig {
+	if len(config) < 1 {
+		config = []ResponseValidationConfig{DefaultResponseValidationConfig}
+	}
+	cfg := config[0]
+
+	if cfg.Registry == nil {
+		cfg.Registry = DefaultRegistry
+	}
+
+	if cfg.OnError == nil {
+		cfg.OnError = func(c fiber.Ctx, err error) {
+			log.Println("gofiber-swagger: invalid response", c.Method(), c.Path(), c.Response().StatusCode(), ":", err)
+		}
+	}
+
+	if cfg.Options == nil {
+		cfg.Options = DefaultResponseValidationConfig.Options
+	}
+
+	return cfg
+}
+
+// NewResponseValidator creates a middleware, which validates the status code, content type and body
+// of the responses of documented operations against the generated document.
+// Operations without any documented responses and errors returned by the handlers
+// (handled later by the app's ErrorHandler) are not validated.
+// Meant for development and tests, since the whole response gets buffered and validated.
+func NewResponseValidator(config ...ResponseValidationConfig) fiber.Handler {
+	cfg := responseValidationConfigDefault(config...)
+
+	return func(c fiber.Ctx) error {
+		if cfg.Next != nil && cfg.Next(c) {
+			return c.Next()
+		}
+
+		route, path_params := cfg.Registry.findValidationRoute(c.Method(), c.Path())
+		if route == nil || isValidationSkipped(route.Operation, ExtensionSkipResponseValidation) ||
+			route.Operation.Responses == nil || route.Operation.Responses.Len() == 0 {
+			return c.Next()
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		request, err := adaptor.ConvertRequest(c, false)
+		if err != nil {
+			return err
+		}
+		input := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: &openapi3filter.RequestValidationInput{
+				Request:    request,
+				PathParams: path_params,
+				Route:      route,
+				Options:    cfg.Options,
+			},
+			Status:  c.Response().StatusCode(),
+			Header:  http.Header(c.GetRespHeaders()),
+			Options: cfg.Options,
+		}
+		input.SetBodyBytes(c.Response().Body())
+
+		err = openapi3filter.ValidateResponse(c.Context(), input)
+		if err == nil {
+			return nil
+		}
+
+		cfg.OnError(c, err)
+		if cfg.FailOnError {
+			c.Response().ResetBody()
+			return sendProblemDetails(c, newProblemDetails(c, fiber.StatusInternalServerError, "response doesn't match the documentation", err))
+		}
+		return nil
+	}
+}
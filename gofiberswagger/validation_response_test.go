@@ -0,0 +1,113 @@
+package gofiberswagger
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type ValidatedResponse struct {
+	ID   int    `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func newResponseValidationTestApp(t *testing.T, config ResponseValidationConfig) *fiber.App {
+	t.Helper()
+
+	registry := NewRegistry(nil)
+	config.Registry = registry
+	app := fiber.New()
+	app.Use(NewResponseValidator(config))
+
+	router := registry.NewRouter(app)
+	docs := func() *RouteInfo {
+		return &RouteInfo{
+			Responses: NewResponses(NewResponseInfo[ValidatedResponse]("200", "ok")),
+		}
+	}
+	router.Get("/valid", docs(), func(c fiber.Ctx) error {
+		return c.JSON(ValidatedResponse{ID: 1, Name: "john"})
+	})
+	router.Get("/invalid-body", docs(), func(c fiber.Ctx) error {
+		return c.JSON(fiber.Map{"id": "not a number"})
+	})
+	router.Get("/undocumented-status", docs(), func(c fiber.Ctx) error {
+		return c.Status(fiber.StatusTeapot).JSON(ValidatedResponse{ID: 1, Name: "john"})
+	})
+	router.Get("/handler-error", docs(), func(c fiber.Ctx) error {
+		return fiber.ErrNotFound
+	})
+	skipped := docs()
+	skipped.Extensions = map[string]any{ExtensionSkipResponseValidation: true}
+	router.Get("/skipped", skipped, func(c fiber.Ctx) error {
+		return c.SendString("anything")
+	})
+	router.Get("/no-responses", nil, func(c fiber.Ctx) error {
+		return c.SendString("anything")
+	})
+
+	assert.NoError(t, registry.register(app, &Config{}))
+	return app
+}
+
+func TestNewResponseValidator(t *testing.T) {
+	t.Parallel()
+
+	reported := atomic.Int32{}
+	app := newResponseValidationTestApp(t, ResponseValidationConfig{
+		OnError: func(c fiber.Ctx, err error) {
+			reported.Add(1)
+		},
+	})
+
+	testCases := []struct {
+		target         string
+		expectedStatus int
+		reported       bool
+	}{
+		{"/valid", 200, false},
+		{"/invalid-body", 200, true},
+		{"/undocumented-status", fiber.StatusTeapot, true},
+		{"/handler-error", 404, false},
+		{"/skipped", 200, false},
+		{"/no-responses", 200, false},
+	}
+	for _, tc := range testCases {
+		reported.Store(0)
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, tc.target, nil))
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expectedStatus, resp.StatusCode, tc.target)
+		assert.Equal(t, tc.reported, reported.Load() > 0, tc.target)
+	}
+}
+
+func TestNewResponseValidator_FailOnError(t *testing.T) {
+	t.Parallel()
+
+	app := newResponseValidationTestApp(t, ResponseValidationConfig{
+		FailOnError: true,
+		OnError:     func(c fiber.Ctx, err error) {},
+	})
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/valid", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = app.Test(httptest.NewRequest(http.MethodGet, "/invalid-body", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, problemDetailsContentType, resp.Header.Get("Content-Type"))
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var problem ProblemDetails
+	assert.NoError(t, json.Unmarshal(body, &problem))
+	assert.Equal(t, http.StatusInternalServerError, problem.Status)
+	assert.NotEmpty(t, problem.Errors)
+}